@@ -13,12 +13,16 @@ import (
 	app "github.com/jdk829355/InForest_back/internal/grpc/forestservice"
 	"github.com/jdk829355/InForest_back/internal/grpc/interceptors/authinterceptor"
 	"github.com/jdk829355/InForest_back/internal/service/auth"
+	"github.com/jdk829355/InForest_back/internal/service/events"
+	"github.com/jdk829355/InForest_back/internal/service/trash"
+	"github.com/jdk829355/InForest_back/internal/service/urlcanon"
 	"github.com/jdk829355/InForest_back/internal/store"
 	gen "github.com/jdk829355/InForest_back/protos/forest"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
@@ -41,29 +45,99 @@ func main() {
 	}
 	logger.Info("Configuration loaded successfully")
 
-	// Neo4j 스토어 초기화
-	driver, err := store.InitNeo4jStore(cfg)
-	if err != nil {
-		logger.Fatal("Failed to connect to Neo4j", zap.Error(err))
+	// 숲/트리 저장소 초기화
+	var forestRepo store.ForestRepository
+	switch cfg.FOREST_STORE {
+	case "memory":
+		logger.Info("Using in-memory forest store")
+		forestRepo = store.NewMemoryForestStore()
+	case "", "neo4j":
+		driver, err := store.InitNeo4jStore(cfg)
+		if err != nil {
+			logger.Fatal("Failed to connect to Neo4j", zap.Error(err))
+		}
+		forestRepo, _ = store.NewNeo4jStore(*driver)
+	default:
+		logger.Fatal("Unknown forest store", zap.String("forest_store", cfg.FOREST_STORE))
 	}
-	// Supabase 스토어 초기화
-	supabaseClient, err := store.InitSupabaseStore(cfg)
-	if err != nil {
-		logger.Fatal("Failed to connect to Supabase", zap.Error(err))
+	// 메모 저장소 초기화
+	var memoStore store.MemoStore
+	switch cfg.MEMO_STORE {
+	case "memory":
+		logger.Info("Using in-memory memo store")
+		memoStore = store.NewMemoryMemoStore()
+	case "postgres":
+		pool, err := store.InitPostgresMemoStore(cfg)
+		if err != nil {
+			logger.Fatal("Failed to connect to PostgreSQL", zap.Error(err))
+		}
+		memoStore, _ = store.NewPostgresMemoStore(pool)
+	case "", "supabase":
+		supabaseClient, err := store.InitSupabaseStore(cfg)
+		if err != nil {
+			logger.Fatal("Failed to connect to Supabase", zap.Error(err))
+		}
+		memoStore, _ = store.NewSupabaseStore(supabaseClient)
+	default:
+		logger.Fatal("Unknown memo store", zap.String("memo_store", cfg.MEMO_STORE))
 	}
-	store := store.NewStore(*driver, supabaseClient)
+	store := store.NewStore(forestRepo, memoStore)
 	ctx := context.Background()
 
 	defer func() {
 		logger.Info("Closing database connection...")
-		if err := store.Neo4j.Close(ctx); err != nil {
+		if err := store.Close(ctx); err != nil {
 			logger.Error("Failed to close database connection", zap.Error(err))
 		}
 	}()
 	logger.Info("Database connection established")
 
+	// 휴지통 정리 작업 시작
+	retention, err := time.ParseDuration(cfg.TRASH_RETENTION)
+	if err != nil {
+		logger.Fatal("Invalid trash retention", zap.String("trash_retention", cfg.TRASH_RETENTION), zap.Error(err))
+	}
+	purgeInterval, err := time.ParseDuration(cfg.TRASH_PURGE_INTERVAL)
+	if err != nil {
+		logger.Fatal("Invalid trash purge interval", zap.String("trash_purge_interval", cfg.TRASH_PURGE_INTERVAL), zap.Error(err))
+	}
+	purger, err := trash.NewPurger(store, retention, purgeInterval, logger)
+	if err != nil {
+		logger.Fatal("Failed to init trash purger", zap.Error(err))
+	}
+	purgeCtx, stopPurger := context.WithCancel(ctx)
+	defer stopPurger()
+	go purger.Run(purgeCtx)
+
 	// gRPC 서버 및 ForestService 초기화
 	forestService := app.NewForestService(store)
+	if cfg.TRACKING_PARAMS != "" {
+		forestService.URLs = urlcanon.New(urlcanon.ParseTrackingParams(cfg.TRACKING_PARAMS))
+	}
+	// canonical_url이 없는 이전 트리도 중복 검사와 URL 조회에 걸리도록 채움
+	filled, err := store.Forest.BackfillCanonicalUrls(ctx, forestService.URLs.Canonicalize)
+	if err != nil {
+		logger.Fatal("Failed to backfill canonical URLs", zap.Error(err))
+	}
+	if filled > 0 {
+		logger.Info("Backfilled canonical URLs", zap.Int("trees", filled))
+	}
+
+	// 숲 변경 이벤트 브로커 설정 (여러 서버가 뜨는 경우 Redis 사용)
+	switch cfg.EVENT_BROKER {
+	case "memory":
+		logger.Info("Using in-process event broker")
+	case "", "redis":
+		rdb := redis.NewClient(&redis.Options{
+			Addr:     cfg.REDIS_HOST + ":" + cfg.REDIS_PORT,
+			Password: cfg.REDIS_PASSWORD,
+			DB:       0,
+		})
+		defer rdb.Close()
+		forestService.Events = events.NewRedisBroker(rdb)
+	default:
+		logger.Fatal("Unknown event broker", zap.String("event_broker", cfg.EVENT_BROKER))
+	}
 
 	listenAddr := fmt.Sprintf(":%s", cfg.GRPC_PORT)
 	l, e := net.Listen("tcp", listenAddr)
@@ -88,7 +162,8 @@ func main() {
 		logger.Fatal("Failed to load jwt secret", zap.Error(err))
 	}
 
-	tokenInterceptor, err := authinterceptor.NewAuthInterceptor(authSvc)
+	// 공개 링크 조회는 JWT 없이 호출 가능
+	tokenInterceptor, err := authinterceptor.NewAuthInterceptor(authSvc, gen.ForestService_GetSharedForest_FullMethodName)
 	if err != nil {
 		logger.Fatal("Failed to init auth interceptor", zap.Error(err))
 	}
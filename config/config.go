@@ -14,6 +14,19 @@ type Config struct {
 	JWT_SECRET    string
 	SUPABASE_URL  string
 	SUPABASE_KEY  string
+	FOREST_STORE  string // 숲/트리 저장소 종류: "neo4j"(기본값) 또는 "memory"
+	MEMO_STORE    string // 메모 저장소 종류: "supabase"(기본값), "postgres" 또는 "memory"
+	POSTGRES_URL  string // MEMO_STORE가 "postgres"일 때 사용할 접속 문자열
+	EVENT_BROKER  string // 숲 변경 이벤트 브로커 종류: "redis"(기본값) 또는 "memory"(단일 서버)
+
+	TRACKING_PARAMS string // URL 정규화 시 제거할 쿼리 파라미터 (쉼표로 구분, *로 끝나면 접두사, 비어 있으면 기본 목록)
+
+	REDIS_HOST     string
+	REDIS_PORT     string
+	REDIS_PASSWORD string
+
+	TRASH_RETENTION      string // 휴지통 보관 기간 (time.ParseDuration 형식, 기본값 720h)
+	TRASH_PURGE_INTERVAL string // 휴지통 정리 주기 (time.ParseDuration 형식, 기본값 1h)
 }
 
 func LoadConfig() (*Config, error) {
@@ -27,5 +40,25 @@ func LoadConfig() (*Config, error) {
 		JWT_SECRET:    os.Getenv("JWT_SECRET"),
 		SUPABASE_URL:  os.Getenv("SUPABASE_URL"),
 		SUPABASE_KEY:  os.Getenv("SUPABASE_KEY"),
+		FOREST_STORE:  os.Getenv("FOREST_STORE"),
+		MEMO_STORE:    os.Getenv("MEMO_STORE"),
+		POSTGRES_URL:  os.Getenv("POSTGRES_URL"),
+		EVENT_BROKER:  os.Getenv("EVENT_BROKER"),
+
+		TRACKING_PARAMS: os.Getenv("TRACKING_PARAMS"),
+
+		REDIS_HOST:     os.Getenv("REDIS_HOST"),
+		REDIS_PORT:     os.Getenv("REDIS_PORT"),
+		REDIS_PASSWORD: os.Getenv("REDIS_PASSWORD"),
+
+		TRASH_RETENTION:      getEnvDefault("TRASH_RETENTION", "720h"),
+		TRASH_PURGE_INTERVAL: getEnvDefault("TRASH_PURGE_INTERVAL", "1h"),
 	}, nil
 }
+
+func getEnvDefault(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
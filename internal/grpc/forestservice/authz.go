@@ -0,0 +1,99 @@
+package forestservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jdk829355/InForest_back/internal/store"
+	"github.com/jdk829355/InForest_back/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// access는 권한 확인을 통과한 호출자의 정보입니다.
+type access struct {
+	userID   string            // 호출자 ID
+	forestID string            // 여러 트리를 확인한 경우 마지막 트리의 숲 ID
+	ownerID  string            // 숲 소유자 ID (메모는 공유된 숲에서도 소유자 기준으로 저장됨)
+	role     models.ForestRole // 호출자의 역할
+}
+
+// 인증 인터셉터가 넣어 준 호출자 ID
+func callerID(ctx context.Context) (string, error) {
+	userID, _ := ctx.Value("user_id").(string)
+	if userID == "" {
+		return "", status.Error(codes.Unauthenticated, "invalid user_id")
+	}
+	return userID, nil
+}
+
+// authorizeForest는 호출자가 숲에 대해 required 이상의 역할을 가지는지 확인합니다.
+// 숲이 없으면 NotFound, 권한이 없으면 PermissionDenied를 반환합니다.
+func (s *ForestService) authorizeForest(ctx context.Context, forestID string, required models.ForestRole) (*access, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ownerID, err := s.Store.Forest.GetForestOwner(ctx, forestID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return s.checkRole(ctx, userID, forestID, ownerID, required)
+}
+
+// authorizeTrees는 호출자가 모든 트리가 속한 숲에 대해 required 이상의 역할을 가지는지 확인합니다.
+// 트리가 없으면 NotFound, 권한이 없으면 PermissionDenied,
+// 트리들의 숲 소유자가 서로 다르면 메모를 옮길 수 없으므로 FailedPrecondition을 반환합니다.
+func (s *ForestService) authorizeTrees(ctx context.Context, required models.ForestRole, treeIDs ...string) (*access, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var acc *access
+	for _, treeID := range treeIDs {
+		forestID, ownerID, err := s.Store.Forest.GetTreeOwner(ctx, treeID)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		treeAcc, err := s.checkRole(ctx, userID, forestID, ownerID, required)
+		if err != nil {
+			return nil, err
+		}
+		if acc != nil && acc.ownerID != treeAcc.ownerID {
+			return nil, toStatus(store.ErrForestOwnerMismatch)
+		}
+		acc = treeAcc
+	}
+	return acc, nil
+}
+
+func (s *ForestService) checkRole(ctx context.Context, userID, forestID, ownerID string, required models.ForestRole) (*access, error) {
+	role := models.ForestRoleOwner
+	if ownerID != userID {
+		var err error
+		if role, err = s.Store.Forest.GetMemberRole(ctx, forestID, userID); err != nil {
+			return nil, toStatus(err)
+		}
+	}
+	if !role.Allows(required) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s role required", required)
+	}
+	return &access{userID: userID, forestID: forestID, ownerID: ownerID, role: role}, nil
+}
+
+// toStatus는 저장소의 sentinel 에러를 gRPC 상태 코드로 바꿉니다.
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, store.ErrForestNotFound), errors.Is(err, store.ErrTreeNotFound), errors.Is(err, store.ErrMemberNotFound),
+		errors.Is(err, store.ErrShareLinkNotFound), errors.Is(err, store.ErrMemoNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, store.ErrForestOwnerMismatch), errors.Is(err, store.ErrMoveIntoDescendant), errors.Is(err, store.ErrMoveRootTree),
+		errors.Is(err, store.ErrRootHasMultipleChildren), errors.Is(err, store.ErrNotInTrash), errors.Is(err, store.ErrRestoreBlocked):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, store.ErrInvalidShare), errors.Is(err, store.ErrInvalidUpdate), errors.Is(err, store.ErrInvalidShareLink),
+		errors.Is(err, store.ErrInvalidTag), errors.Is(err, store.ErrInvalidQuery), errors.Is(err, store.ErrInvalidVisit),
+		errors.Is(err, store.ErrInvalidChildOrder), errors.Is(err, store.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
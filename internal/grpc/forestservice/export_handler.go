@@ -0,0 +1,137 @@
+package forestservice
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jdk829355/InForest_back/internal/service/export"
+	"github.com/jdk829355/InForest_back/models"
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 내보내기 청크 하나의 최대 바이트 수
+const exportChunkBytes = 32 * 1024
+
+var exportFormats = map[forest.ExportFormat]export.Format{
+	forest.ExportFormat_EXPORT_FORMAT_JSON:          export.FormatJSON,
+	forest.ExportFormat_EXPORT_FORMAT_MARKDOWN:      export.FormatMarkdown,
+	forest.ExportFormat_EXPORT_FORMAT_OPML:          export.FormatOPML,
+	forest.ExportFormat_EXPORT_FORMAT_NETSCAPE_HTML: export.FormatNetscape,
+}
+
+// 숲 전체를 요청한 형식으로 변환해 바이트 청크로 전송
+// 첫 번째 청크에는 content_type과 filename이 함께 담김
+func (s *ForestService) ExportForest(req *forest.ExportForestRequest, stream forest.ForestService_ExportForestServer) error {
+	ctx := stream.Context()
+	format, ok := exportFormats[req.GetFormat()]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown export format: %s", req.GetFormat())
+	}
+	acc, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleViewer)
+	if err != nil {
+		return err
+	}
+	forestModel, err := s.Store.Forest.GetForest(ctx, req.GetForestId(), true, 0)
+	if err != nil {
+		return toStatus(err)
+	}
+
+	var memos map[string]string
+	if req.GetIncludeMemos() {
+		memos, err = s.collectMemos(ctx, acc.ownerID, forestModel.Root)
+		if err != nil {
+			return toStatus(err)
+		}
+	}
+
+	w := &exportWriter{
+		stream: stream,
+		first: &forest.ExportForestChunk{
+			ContentType: format.ContentType(),
+			Filename:    exportFilename(forestModel.Name) + format.Extension(),
+		},
+	}
+	if err := export.Write(w, format, forestModel, memos); err != nil {
+		return err
+	}
+	return w.flush()
+}
+
+// collectMemos는 root 아래 모든 트리의 메모를 한 번에 조회해 트리 ID → 내용으로 모읍니다. 메모가 없는 트리는 빠집니다.
+func (s *ForestService) collectMemos(ctx context.Context, ownerID string, root *models.Tree) (map[string]string, error) {
+	var treeIDs []string
+	var walk func(tree *models.Tree)
+	walk = func(tree *models.Tree) {
+		if tree == nil {
+			return
+		}
+		treeIDs = append(treeIDs, tree.Id)
+		for _, child := range tree.Children {
+			walk(child)
+		}
+	}
+	walk(root)
+
+	found, err := s.Store.Memo.GetMemos(ctx, ownerID, treeIDs)
+	if err != nil {
+		return nil, err
+	}
+	memos := make(map[string]string, len(found))
+	for _, memo := range found {
+		memos[memo.TreeID] = memo.Content
+	}
+	return memos, nil
+}
+
+// exportFilename은 숲 이름에서 파일 이름에 쓸 수 없는 문자를 '_'로 바꿉니다.
+func exportFilename(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if name == "" {
+		return "forest"
+	}
+	return name
+}
+
+// exportWriter는 쓰인 바이트를 모아 exportChunkBytes 단위로 전송합니다.
+// 아무것도 쓰이지 않아도 flush에서 첫 번째 청크는 한 번 보냅니다.
+type exportWriter struct {
+	stream forest.ForestService_ExportForestServer
+	first  *forest.ExportForestChunk // 아직 보내지 않은 첫 번째 청크의 메타데이터
+	buf    []byte
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= exportChunkBytes {
+		if err := w.send(w.buf[:exportChunkBytes]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[exportChunkBytes:]
+	}
+	return len(p), nil
+}
+
+func (w *exportWriter) flush() error {
+	if len(w.buf) == 0 && w.first == nil {
+		return nil
+	}
+	err := w.send(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *exportWriter) send(data []byte) error {
+	chunk := &forest.ExportForestChunk{}
+	if w.first != nil {
+		chunk, w.first = w.first, nil
+	}
+	chunk.Data = append([]byte(nil), data...)
+	return w.stream.Send(chunk)
+}
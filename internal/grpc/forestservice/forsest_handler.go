@@ -2,98 +2,244 @@ package forestservice
 
 import (
 	"context"
-	"errors"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/jdk829355/InForest_back/internal/store"
 	"github.com/jdk829355/InForest_back/models"
 	"github.com/jdk829355/InForest_back/protos/forest"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *ForestService) GetForestsByUser(ctx context.Context, req *forest.GetForestsByUserRequest) (*forest.GetForestsByUserResponse, error) {
-	user_id := ctx.Value("user_id")
-	if user_id == "" {
-		return nil, errors.New("invalid user_id")
-	}
-	forests, err := s.Store.Neo4j.GetForestByUser(ctx, user_id.(string), req.GetIncludeChildren())
+	userID, err := callerID(ctx)
 	if err != nil {
 		return nil, err
 	}
+	forests, nextPageToken, err := s.Store.Forest.GetForestByUser(ctx, userID, store.ForestListOptions{
+		IncludeChildren: req.GetIncludeChildren(),
+		PageSize:        int(req.GetPageSize()),
+		PageToken:       req.GetPageToken(),
+		SortBy:          forestSortFields[req.GetSortBy()],
+		Descending:      req.GetDescending(),
+		NamePrefix:      req.GetNamePrefix(),
+		MinTrees:        req.GetMinTrees(),
+		IncludeShared:   req.GetIncludeShared(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
 	forestsProto := make([]*forest.Forest, len(forests))
 	for i, f := range forests {
 		forestsProto[i] = f.ToProto()
 	}
 	return &forest.GetForestsByUserResponse{
-		Forests: forestsProto,
+		Forests:       forestsProto,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
+var forestSortFields = map[forest.ForestSortField]store.ForestSort{
+	forest.ForestSortField_FOREST_SORT_FIELD_UNSPECIFIED: store.ForestSortName,
+	forest.ForestSortField_FOREST_SORT_FIELD_NAME:        store.ForestSortName,
+	forest.ForestSortField_FOREST_SORT_FIELD_CREATED_AT:  store.ForestSortCreatedAt,
+	forest.ForestSortField_FOREST_SORT_FIELD_UPDATED_AT:  store.ForestSortUpdatedAt,
+}
+
 func (s *ForestService) CreateForest(ctx context.Context, req *forest.CreateForestRequest) (*forest.Forest, error) {
-	user_id := ctx.Value("user_id")
-	if user_id == "" {
-		return nil, errors.New("invalid user_id")
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	root := &models.Tree{
-		Id:   req.GetRoot().GetId(),
-		Name: req.GetRoot().GetName(),
-		Url:  req.GetRoot().GetUrl(),
+		Id:           req.GetRoot().GetId(),
+		Name:         req.GetRoot().GetName(),
+		Url:          req.GetRoot().GetUrl(),
+		CanonicalUrl: s.URLs.Canonicalize(req.GetRoot().GetUrl()),
 	}
 	forestModel := &models.Forest{
 		Name:        req.GetName(),
 		Description: req.GetDescription(),
-		UserId:      user_id.(string),
+		UserId:      userID,
 		Root:        root,
 	}
-	if err := s.Store.Neo4j.CreateForest(ctx, forestModel, root); err != nil {
+	if err := s.Store.Forest.CreateForest(ctx, forestModel, root); err != nil {
+		return nil, toStatus(err)
+	}
+	// 루트 트리의 메모 생성 (실패하면 만든 숲을 영구 삭제)
+	if _, err := s.Store.Memo.CreateMemo(ctx, userID, root.Id, nil); err != nil {
+		_, _ = s.Store.Forest.PurgeForest(ctx, forestModel.Id)
 		return nil, err
 	}
-	s.Store.Supabase.CreateMemo(user_id.(string), root.Id, nil)
 	return forestModel.ToProto(), nil
 }
 
 func (s *ForestService) GetForest(ctx context.Context, req *forest.GetForestRequest) (*forest.GetForestResponse, error) {
-	forestModel, err := s.Store.Neo4j.GetForest(ctx, req.GetForestId(), req.GetIncludeChildren())
-	if err != nil {
+	if _, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleViewer); err != nil {
 		return nil, err
 	}
+	forestModel, err := s.Store.Forest.GetForest(ctx, req.GetForestId(), req.GetIncludeChildren(), int(req.GetMaxDepth()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
 	return &forest.GetForestResponse{
 		Forest: forestModel.ToProto(),
 	}, nil
 }
 
 func (s *ForestService) UpdateForest(ctx context.Context, req *forest.UpdateForestRequest) (*forest.Forest, error) {
+	if req.GetForestId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "forest_id is required")
+	}
+	if _, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleEditor); err != nil {
+		return nil, err
+	}
 	inputForestModel := &models.Forest{
 		Id:          req.GetForestId(),
 		Name:        req.GetName(),
 		Description: req.GetDescription(),
 	}
-	forestModel, err := s.Store.Neo4j.UpdateForest(ctx, inputForestModel)
+	// update_mask가 없으면 비어 있지 않은 필드만 업데이트
+	fields := req.GetUpdateMask().GetPaths()
+	if req.GetUpdateMask() == nil {
+		if req.GetName() != "" {
+			fields = append(fields, "name")
+		}
+		if req.GetDescription() != "" {
+			fields = append(fields, "description")
+		}
+	}
+	forestModel, err := s.Store.Forest.UpdateForest(ctx, inputForestModel, fields)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventForestUpdated, ForestId: forestModel.Id, Forest: &forestModel})
 	return forestModel.ToProto(), nil
 }
 
+// 숲을 휴지통으로 옮김
+// 메모는 영구 삭제될 때까지 유지됨
 func (s *ForestService) DeleteForest(ctx context.Context, req *forest.DeleteForestRequest) (*forest.DeleteForestResponse, error) {
-	idsToDelete, err := s.Store.Neo4j.DeleteForest(ctx, req.GetForestId())
-	ctxzap.Extract(ctx).Info("Deleted forest", zap.String("forest_id", req.GetForestId()), zap.Strings("idsToDelete", idsToDelete))
+	if _, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleOwner); err != nil {
+		return nil, err
+	}
+	trashedIds, err := s.Store.Forest.DeleteForest(ctx, req.GetForestId())
+	ctxzap.Extract(ctx).Info("Moved forest to trash", zap.String("forest_id", req.GetForestId()), zap.Strings("trashedIds", trashedIds))
 	if err != nil {
 		return &forest.DeleteForestResponse{
 			Success: false,
-		}, err
-	}
-	user_id := ctx.Value("user_id")
-	if user_id == "" {
-		return nil, errors.New("invalid user_id")
-	}
-	for _, treeID := range idsToDelete {
-		_, err := s.Store.Supabase.DeleteMemo(user_id.(string), treeID)
-		if err != nil {
-			ctxzap.Extract(ctx).Error("Failed to delete memo", zap.String("tree_id", treeID), zap.Error(err))
-		}
+		}, toStatus(err)
 	}
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventForestDeleted, ForestId: req.GetForestId()})
 	return &forest.DeleteForestResponse{
 		Success: true,
 	}, nil
 }
+
+// 하위 트리를 떼어내 새로운 숲으로 만듦
+// 메모는 트리 ID 기준으로 저장되므로 그대로 유지됨
+func (s *ForestService) SplitForest(ctx context.Context, req *forest.SplitForestRequest) (*forest.Forest, error) {
+	acc, err := s.authorizeTrees(ctx, models.ForestRoleOwner, req.GetTreeId())
+	if err != nil {
+		return nil, err
+	}
+	forestModel, err := s.Store.Forest.SplitForest(ctx, req.GetTreeId(), &models.Forest{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventTreeDeleted, ForestId: acc.forestID, TreeId: req.GetTreeId()})
+	return forestModel.ToProto(), nil
+}
+
+// 숲 전체를 다른 숲의 트리 아래로 붙임
+// 메모는 트리 ID 기준으로 저장되므로 그대로 유지됨
+func (s *ForestService) GraftForest(ctx context.Context, req *forest.GraftForestRequest) (*forest.Forest, error) {
+	// 원래 숲은 삭제되므로 소유자만 가능
+	src, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.authorizeTrees(ctx, models.ForestRoleEditor, req.GetTargetTreeId())
+	if err != nil {
+		return nil, err
+	}
+	if src.ownerID != target.ownerID {
+		return nil, toStatus(store.ErrForestOwnerMismatch)
+	}
+	forestModel, err := s.Store.Forest.GraftForest(ctx, req.GetForestId(), req.GetTargetTreeId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventForestDeleted, ForestId: req.GetForestId()})
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventForestUpdated, ForestId: forestModel.Id, Forest: forestModel})
+	return forestModel.ToProto(), nil
+}
+
+// 숲 전체를 새 숲으로 복사 (연구 템플릿 용도)
+func (s *ForestService) CloneForest(ctx context.Context, req *forest.CloneForestRequest) (*forest.Forest, error) {
+	acc, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	cloned, idMap, err := s.Store.Forest.CloneForest(ctx, req.GetForestId(), req.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if err := s.copyMemos(ctx, acc.ownerID, idMap, req.GetIncludeMemos()); err != nil {
+		// 롤백: 복사된 숲 영구 삭제
+		_, _ = s.Store.Forest.PurgeForest(ctx, cloned.Id)
+		return nil, err
+	}
+	return cloned.ToProto(), nil
+}
+
+const (
+	defaultStreamChunkSize = 200
+	maxStreamChunkSize     = 1000
+)
+
+// 숲의 트리를 너비 우선으로 청크 단위로 전송
+// 전체 트리를 메모리에 만들지 않도록 저장소에서 읽는 대로 청크를 채워 보냄
+func (s *ForestService) StreamForest(req *forest.StreamForestRequest, stream forest.ForestService_StreamForestServer) error {
+	ctx := stream.Context()
+	if _, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleViewer); err != nil {
+		return err
+	}
+	forestModel, err := s.Store.Forest.GetForest(ctx, req.GetForestId(), false, 0)
+	if err != nil {
+		return toStatus(err)
+	}
+	forestModel.Root = nil
+
+	chunkSize := int(req.GetChunkSize())
+	if chunkSize <= 0 {
+		chunkSize = defaultStreamChunkSize
+	}
+	chunkSize = min(chunkSize, maxStreamChunkSize)
+
+	chunk := &forest.StreamForestChunk{Forest: forestModel.ToProto()}
+	err = s.Store.Forest.WalkForest(ctx, req.GetForestId(), func(node *models.TreeNode) error {
+		chunk.Nodes = append(chunk.Nodes, node.ToProto())
+		if len(chunk.Nodes) < chunkSize {
+			return nil
+		}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+		chunk = &forest.StreamForestChunk{}
+		return nil
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+	// 남은 트리 전송 (트리가 없는 숲도 숲 정보는 한 번 보냄)
+	if len(chunk.Nodes) > 0 || chunk.Forest != nil {
+		return stream.Send(chunk)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package forestservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/jdk829355/InForest_back/internal/service/history"
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 한 번에 가져올 수 있는 최대 방문 기록 수
+// 넘는 기록은 메모리에 쌓지 않고 records_skipped에만 셈
+const maxImportRecords = 10000
+
+var historyTransitions = map[forest.HistoryTransition]history.Transition{
+	forest.HistoryTransition_HISTORY_TRANSITION_UNSPECIFIED:   history.TransitionUnknown,
+	forest.HistoryTransition_HISTORY_TRANSITION_LINK:          history.TransitionLink,
+	forest.HistoryTransition_HISTORY_TRANSITION_TYPED:         history.TransitionTyped,
+	forest.HistoryTransition_HISTORY_TRANSITION_AUTO_BOOKMARK: history.TransitionBookmark,
+	forest.HistoryTransition_HISTORY_TRANSITION_GENERATED:     history.TransitionGenerated,
+	forest.HistoryTransition_HISTORY_TRANSITION_FORM_SUBMIT:   history.TransitionFormSubmit,
+	forest.HistoryTransition_HISTORY_TRANSITION_RELOAD:        history.TransitionReload,
+	forest.HistoryTransition_HISTORY_TRANSITION_SUBFRAME:      history.TransitionSubframe,
+}
+
+// 브라우저 방문 기록을 받아 세션마다 호출자의 숲을 만들고 결과를 알려 줌
+// 트리마다 CreateTree와 같이 빈 메모를 만들며, 각 기록은 트리의 방문 기록으로 저장됨
+func (s *ForestService) ImportHistory(stream forest.ForestService_ImportHistoryServer) error {
+	ctx := stream.Context()
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	gap := history.DefaultSessionGap
+	var records []history.Record
+	var skipped []history.Skipped
+	overflow := 0
+	for first := true; ; first = false {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if options := req.GetOptions(); options != nil {
+			if !first {
+				return status.Error(codes.InvalidArgument, "options must be the first message")
+			}
+			if minutes := options.GetSessionGapMinutes(); minutes > 0 {
+				gap = time.Duration(minutes) * time.Minute
+			}
+			continue
+		}
+		record := req.GetRecord()
+		index := len(records)
+		if index >= maxImportRecords {
+			overflow++
+			continue
+		}
+		// 시각을 해석할 수 없으면 0 값으로 두어 history.Group에서 건너뜀
+		visitedAt, _ := time.Parse(time.RFC3339, record.GetVisitedAt())
+		records = append(records, history.Record{
+			Index:      index,
+			Url:        record.GetUrl(),
+			Title:      record.GetTitle(),
+			VisitedAt:  visitedAt,
+			Referrer:   record.GetReferrer(),
+			Transition: historyTransitions[record.GetTransition()],
+			DwellMs:    record.GetDwellMs(),
+		})
+	}
+
+	sessions, invalid := history.Group(records, history.Options{UserID: userID, Gap: gap, URLs: s.URLs})
+	skipped = append(skipped, invalid...)
+	slices.SortFunc(skipped, func(a, b history.Skipped) int { return a.Index - b.Index })
+
+	report := &forest.ImportHistoryReport{RecordsSkipped: int32(len(skipped) + overflow)}
+	for _, sk := range skipped {
+		report.Skipped = append(report.Skipped, &forest.SkippedRecord{Index: int32(sk.Index), Url: sk.Url, Reason: sk.Reason})
+	}
+	for i, session := range sessions {
+		if err := s.importSession(ctx, session); err != nil {
+			// 일부 숲만 남지 않도록 앞서 가져온 세션도 지움
+			for _, imported := range sessions[:i] {
+				s.discardSession(ctx, imported, len(imported.Trees))
+			}
+			return toStatus(err)
+		}
+		forestProto := session.Forest.ToProto()
+		forestProto.Root.Children = nil
+		report.Forests = append(report.Forests, forestProto)
+		report.TreesCreated += int32(len(session.Trees))
+		report.VisitsRecorded += int32(len(session.Visits))
+	}
+	return stream.SendAndClose(report)
+}
+
+// importSession은 세션을 숲으로 저장하고 트리마다 메모를 만듭니다.
+// 메모를 만들지 못하면 그때까지 만든 메모와 숲을 지웁니다.
+func (s *ForestService) importSession(ctx context.Context, session *history.Session) error {
+	if err := s.Store.Forest.ImportForest(ctx, session.Forest, session.Visits); err != nil {
+		return err
+	}
+	for i, tree := range session.Trees {
+		if _, err := s.Store.Memo.CreateMemo(ctx, session.Forest.UserId, tree.Id, nil); err != nil {
+			s.discardSession(ctx, session, i)
+			return err
+		}
+	}
+	return nil
+}
+
+// discardSession은 저장한 세션의 숲과 앞쪽 memos개 트리의 메모를 지웁니다.
+// 클라이언트가 가져오기를 취소해도 지울 수 있도록 취소되지 않는 컨텍스트를 사용하며, 실패는 로그로 남깁니다.
+func (s *ForestService) discardSession(ctx context.Context, session *history.Session, memos int) {
+	ctx = context.WithoutCancel(ctx)
+	logger := ctxzap.Extract(ctx)
+	for _, created := range session.Trees[:memos] {
+		if _, err := s.Store.Memo.DeleteMemo(ctx, session.Forest.UserId, created.Id); err != nil {
+			logger.Warn("Failed to delete imported memo", zap.String("tree_id", created.Id), zap.Error(err))
+		}
+	}
+	if _, err := s.Store.Forest.PurgeForest(ctx, session.Forest.Id); err != nil {
+		logger.Warn("Failed to purge imported forest", zap.String("forest_id", session.Forest.Id), zap.Error(err))
+	}
+}
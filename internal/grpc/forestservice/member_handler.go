@@ -0,0 +1,61 @@
+package forestservice
+
+import (
+	"context"
+
+	"github.com/jdk829355/InForest_back/models"
+	"github.com/jdk829355/InForest_back/protos/forest"
+)
+
+// 숲을 다른 사용자에게 viewer 또는 editor로 공유 (소유자만 가능)
+func (s *ForestService) ShareForest(ctx context.Context, req *forest.ShareForestRequest) (*forest.ForestMember, error) {
+	if _, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleOwner); err != nil {
+		return nil, err
+	}
+	member, err := s.Store.Forest.ShareForest(ctx, req.GetForestId(), req.GetUserId(), models.ForestRoleFromProto(req.GetRole()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventMembersChanged, ForestId: req.GetForestId()})
+	return member.ToProto(), nil
+}
+
+// 소유자는 누구든 공유 해제할 수 있고, 멤버는 자신만 나갈 수 있음
+func (s *ForestService) UnshareForest(ctx context.Context, req *forest.UnshareForestRequest) (*forest.UnshareForestResponse, error) {
+	required := models.ForestRoleOwner
+	if userID, err := callerID(ctx); err == nil && userID == req.GetUserId() {
+		required = models.ForestRoleViewer
+	}
+	if _, err := s.authorizeForest(ctx, req.GetForestId(), required); err != nil {
+		return nil, err
+	}
+	if err := s.Store.Forest.UnshareForest(ctx, req.GetForestId(), req.GetUserId()); err != nil {
+		return &forest.UnshareForestResponse{
+			Success: false,
+		}, toStatus(err)
+	}
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventMembersChanged, ForestId: req.GetForestId()})
+	return &forest.UnshareForestResponse{
+		Success: true,
+	}, nil
+}
+
+// 소유자를 첫 번째로 하여 숲의 멤버 목록 반환
+func (s *ForestService) ListForestMembers(ctx context.Context, req *forest.ListForestMembersRequest) (*forest.ListForestMembersResponse, error) {
+	acc, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.Store.Forest.ListForestMembers(ctx, req.GetForestId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	owner := &models.ForestMember{UserId: acc.ownerID, Role: models.ForestRoleOwner}
+	membersProto := []*forest.ForestMember{owner.ToProto()}
+	for _, member := range members {
+		membersProto = append(membersProto, member.ToProto())
+	}
+	return &forest.ListForestMembersResponse{
+		Members: membersProto,
+	}, nil
+}
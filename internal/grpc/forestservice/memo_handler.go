@@ -5,67 +5,82 @@ import (
 	"errors"
 	"time"
 
+	"github.com/jdk829355/InForest_back/internal/store"
+	"github.com/jdk829355/InForest_back/models"
 	"github.com/jdk829355/InForest_back/protos/forest"
 )
 
 func (s *ForestService) GetMemo(ctx context.Context, req *forest.GetMemoRequest) (*forest.Memo, error) {
-	user_id := ctx.Value("user_id")
-	if user_id == "" {
-		return nil, errors.New("invalid user_id")
-	}
-	memo, err := s.Store.Supabase.GetMemo(user_id.(string), req.GetTreeId())
+	acc, err := s.authorizeTrees(ctx, models.ForestRoleViewer, req.GetTreeId())
 	if err != nil {
 		return nil, err
 	}
+	memo, err := s.Store.Memo.GetMemo(ctx, acc.ownerID, req.GetTreeId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
 	return memo.ToProto(), nil
 }
 
-func (s *ForestService) UpdateMemo(ctx context.Context, req *forest.UpdateMemoRequest) (*forest.UpdateMemoResponse, error) {
-	// 1. 버전 비교
-	// 2. 요청에 있는 base_version과 현재 버전이 같은지 확인
-	// 2-1. 같으면 업데이트 하고 success 반환
-	// 2-2. base < current: 누군가가 중간에 업데이트를 함 -> false 반환
-	// 2-3. base > current: 말도 안되는 상황 -> 에러 반환
-	// 필요한 db 함수
-	// - GetMemo
-	// - UpdateMemo
-	user_id := ctx.Value("user_id")
+// 강제 업데이트 시 다른 요청과 경합할 경우 재시도할 횟수
+const forceUpdateMemoRetries = 3
 
-	memo, err := s.Store.Supabase.GetMemo(user_id.(string), req.GetMemo().GetTreeId())
+func (s *ForestService) UpdateMemo(ctx context.Context, req *forest.UpdateMemoRequest) (*forest.UpdateMemoResponse, error) {
+	// 1. 요청에 있는 base_version을 기대 버전으로 하여 조건부 업데이트
+	// 1-1. 저장된 버전과 같으면 업데이트 하고 success 반환
+	// 1-2. base < current: 누군가가 중간에 업데이트를 함 -> false 반환
+	// 1-3. base > current: 말도 안되는 상황 -> 에러 반환
+	// 버전 비교와 갱신은 MemoStore.UpdateMemo에서 원자적으로 처리됨
+	treeID := req.GetMemo().GetTreeId()
+	acc, err := s.authorizeTrees(ctx, models.ForestRoleEditor, treeID)
 	if err != nil {
 		return nil, err
 	}
 
 	// 강제로 업데이트 하는 경우 (덮어쓰기)
 	if req.GetForce() {
-		newMemo, err := s.Store.Supabase.UpdateMemo(user_id.(string), req.GetMemo().GetTreeId(), req.GetMemo().GetContent(), memo.Version+1)
-		if err != nil {
-			return nil, err
+		for i := 0; i < forceUpdateMemoRetries; i++ {
+			memo, err := s.Store.Memo.GetMemo(ctx, acc.ownerID, treeID)
+			if err != nil {
+				return nil, toStatus(err)
+			}
+			newMemo, err := s.Store.Memo.UpdateMemo(ctx, acc.ownerID, treeID, req.GetMemo().GetContent(), memo.Version)
+			if errors.Is(err, store.ErrMemoVersionConflict) {
+				continue
+			}
+			if err != nil {
+				return nil, toStatus(err)
+			}
+			return &forest.UpdateMemoResponse{
+				Success:  true,
+				NewMemo:  newMemo.ToProto(),
+				SyncedAt: newMemo.UpdatedAt.Format(time.RFC3339),
+			}, nil
 		}
 		return &forest.UpdateMemoResponse{
-			Success:  true,
-			NewMemo:  newMemo.ToProto(),
-			SyncedAt: time.Now().Format(time.RFC3339),
-		}, nil
+			Success: false,
+		}, store.ErrMemoVersionConflict
 	}
 
-	if req.GetMemo().GetVersion() < memo.Version {
-		// 2-2
-		return &forest.UpdateMemoResponse{
-			Success: false,
-		}, errors.New("conflict: other version exists")
-	} else if req.GetMemo().GetVersion() > memo.Version {
-		// 2-3
-		return nil, errors.New("invalid version")
-	} else {
-		newMemo, err := s.Store.Supabase.UpdateMemo(user_id.(string), req.GetMemo().GetTreeId(), req.GetMemo().GetContent(), req.GetMemo().GetVersion()+1)
-		if err != nil {
-			return nil, err
+	newMemo, err := s.Store.Memo.UpdateMemo(ctx, acc.ownerID, treeID, req.GetMemo().GetContent(), req.GetMemo().GetVersion())
+	if errors.Is(err, store.ErrMemoVersionConflict) {
+		memo, getErr := s.Store.Memo.GetMemo(ctx, acc.ownerID, treeID)
+		if getErr == nil && req.GetMemo().GetVersion() > memo.Version {
+			// 1-3
+			return nil, errors.New("invalid version")
 		}
+		// 1-2
 		return &forest.UpdateMemoResponse{
-			Success:  true,
-			NewMemo:  newMemo.ToProto(),
-			SyncedAt: time.Now().Format(time.RFC3339),
-		}, nil
+			Success: false,
+		}, err
+	}
+	if err != nil {
+		return nil, toStatus(err)
 	}
+	// 1-1
+	return &forest.UpdateMemoResponse{
+		Success:  true,
+		NewMemo:  newMemo.ToProto(),
+		SyncedAt: newMemo.UpdatedAt.Format(time.RFC3339),
+	}, nil
 }
@@ -0,0 +1,70 @@
+package forestservice
+
+import (
+	"context"
+
+	"github.com/jdk829355/InForest_back/internal/store"
+	"github.com/jdk829355/InForest_back/models"
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 접근할 수 있는 숲의 트리 이름, URL, 요약과 메모 내용 검색
+// forest_id를 지정하면 해당 숲에 대한 viewer 이상 권한이 필요함
+func (s *ForestService) Search(ctx context.Context, req *forest.SearchRequest) (*forest.SearchResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetForestId() != "" {
+		if _, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleViewer); err != nil {
+			return nil, err
+		}
+	}
+	hits, err := s.Store.Search(ctx, userID, req.GetQuery(), store.SearchOptions{
+		Scope:    searchScopes[req.GetScope()],
+		ForestID: req.GetForestId(),
+		Limit:    int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	hitsProto := make([]*forest.SearchHit, len(hits))
+	for i, hit := range hits {
+		hitsProto[i] = hit.ToProto()
+	}
+	return &forest.SearchResponse{
+		Hits: hitsProto,
+	}, nil
+}
+
+// 호출자가 소유하거나 공유받은 모든 숲에서 같은 페이지를 방문한 트리 조회
+func (s *ForestService) FindTreesByUrl(ctx context.Context, req *forest.FindTreesByUrlRequest) (*forest.FindTreesByUrlResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	canonicalURL := s.URLs.Canonicalize(req.GetUrl())
+	if canonicalURL == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+	matches, err := s.Store.Forest.FindTreesByUrl(ctx, userID, canonicalURL)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	matchesProto := make([]*forest.UrlMatch, len(matches))
+	for i, match := range matches {
+		matchesProto[i] = match.ToProto()
+	}
+	return &forest.FindTreesByUrlResponse{
+		CanonicalUrl: canonicalURL,
+		Matches:      matchesProto,
+	}, nil
+}
+
+var searchScopes = map[forest.SearchScope]store.SearchScope{
+	forest.SearchScope_SEARCH_SCOPE_ALL:    store.SearchScopeAll,
+	forest.SearchScope_SEARCH_SCOPE_OWNED:  store.SearchScopeOwned,
+	forest.SearchScope_SEARCH_SCOPE_SHARED: store.SearchScopeShared,
+}
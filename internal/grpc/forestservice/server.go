@@ -1,17 +1,23 @@
 package forestservice
 
 import (
+	"github.com/jdk829355/InForest_back/internal/service/events"
+	"github.com/jdk829355/InForest_back/internal/service/urlcanon"
 	"github.com/jdk829355/InForest_back/internal/store"
 	"github.com/jdk829355/InForest_back/protos/forest"
 )
 
 type ForestService struct {
 	forest.UnimplementedForestServiceServer
-	Store *store.Store
+	Store  *store.Store
+	Events events.Broker           // 숲 변경 이벤트 전달 (기본값은 프로세스 내 브로커)
+	URLs   *urlcanon.Canonicalizer // 트리 URL 정규화 (기본값은 기본 추적 파라미터 목록 사용)
 }
 
 func NewForestService(store *store.Store) *ForestService {
 	return &ForestService{
-		Store: store,
+		Store:  store,
+		Events: events.NewMemoryBroker(),
+		URLs:   urlcanon.New(urlcanon.DefaultTrackingParams),
 	}
 }
@@ -0,0 +1,101 @@
+package forestservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/jdk829355/InForest_back/models"
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// expires_at이 없을 때 링크 유효 기간
+const defaultShareLinkTTL = 7 * 24 * time.Hour
+
+// 계정 없이 숲을 볼 수 있는 공개 링크 생성 (소유자만 가능)
+// 토큰은 이 응답에서만 확인할 수 있음
+func (s *ForestService) CreateShareLink(ctx context.Context, req *forest.CreateShareLinkRequest) (*forest.ShareLink, error) {
+	if _, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleOwner); err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(defaultShareLinkTTL)
+	if req.GetExpiresAt() != "" {
+		var err error
+		if expiresAt, err = time.Parse(time.RFC3339, req.GetExpiresAt()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be RFC3339")
+		}
+	}
+	link, err := s.Store.Forest.CreateShareLink(ctx, req.GetForestId(), expiresAt)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return link.ToProto(), nil
+}
+
+func (s *ForestService) ListShareLinks(ctx context.Context, req *forest.ListShareLinksRequest) (*forest.ListShareLinksResponse, error) {
+	if _, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleOwner); err != nil {
+		return nil, err
+	}
+	links, err := s.Store.Forest.ListShareLinks(ctx, req.GetForestId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	linksProto := make([]*forest.ShareLink, len(links))
+	for i, link := range links {
+		linksProto[i] = link.ToProto()
+	}
+	return &forest.ListShareLinksResponse{
+		Links: linksProto,
+	}, nil
+}
+
+func (s *ForestService) RevokeShareLink(ctx context.Context, req *forest.RevokeShareLinkRequest) (*forest.RevokeShareLinkResponse, error) {
+	if _, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleOwner); err != nil {
+		return nil, err
+	}
+	if err := s.Store.Forest.RevokeShareLink(ctx, req.GetForestId(), req.GetLinkId()); err != nil {
+		return &forest.RevokeShareLinkResponse{
+			Success: false,
+		}, toStatus(err)
+	}
+	return &forest.RevokeShareLinkResponse{
+		Success: true,
+	}, nil
+}
+
+// 공개 링크로 숲 조회 (인증 인터셉터를 거치지 않음)
+// 트리 구조와 요약만 반환하고 메모, 소유자 ID, 태그, 방문 기록 집계는 반환하지 않음
+func (s *ForestService) GetSharedForest(ctx context.Context, req *forest.GetSharedForestRequest) (*forest.GetSharedForestResponse, error) {
+	link, err := s.Store.Forest.GetShareLink(ctx, req.GetToken())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	forestModel, err := s.Store.Forest.GetForest(ctx, link.ForestId, true, 0)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	forestModel.UserId = ""
+	forestModel.Tags = nil
+	stripPrivateTreeFields(forestModel.Root)
+	return &forest.GetSharedForestResponse{
+		Forest:    forestModel.ToProto(),
+		ExpiresAt: timestamppb.New(link.ExpiresAt),
+	}, nil
+}
+
+// stripPrivateTreeFields는 하위 트리 전체에서 소유자의 태그와 방문 기록 집계를 지웁니다.
+func stripPrivateTreeFields(tree *models.Tree) {
+	if tree == nil {
+		return
+	}
+	tree.Tags = nil
+	tree.FirstVisitedAt = time.Time{}
+	tree.LastVisitedAt = time.Time{}
+	tree.VisitCount = 0
+	tree.TotalDwellMs = 0
+	for _, child := range tree.Children {
+		stripPrivateTreeFields(child)
+	}
+}
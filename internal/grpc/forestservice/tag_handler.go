@@ -0,0 +1,93 @@
+package forestservice
+
+import (
+	"context"
+
+	"github.com/jdk829355/InForest_back/models"
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type tagUpdater func(ctx context.Context, id string, tags []string) ([]string, error)
+
+// 숲 또는 트리에 태그 추가 (editor 이상 가능)
+func (s *ForestService) AddTags(ctx context.Context, req *forest.TagsRequest) (*forest.TagsResponse, error) {
+	return s.updateTags(ctx, req, s.Store.Forest.AddForestTags, s.Store.Forest.AddTreeTags)
+}
+
+func (s *ForestService) RemoveTags(ctx context.Context, req *forest.TagsRequest) (*forest.TagsResponse, error) {
+	return s.updateTags(ctx, req, s.Store.Forest.RemoveForestTags, s.Store.Forest.RemoveTreeTags)
+}
+
+// updateTags는 forest_id와 tree_id 중 지정된 대상의 태그를 바꾸고 변경을 알림
+// 태그는 숲 소유자의 것으로 저장되므로 공유받은 숲에서 붙인 태그도 소유자의 태그 목록에 나타남
+func (s *ForestService) updateTags(ctx context.Context, req *forest.TagsRequest, updateForest tagUpdater, updateTree tagUpdater) (*forest.TagsResponse, error) {
+	if (req.GetForestId() == "") == (req.GetTreeId() == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of forest_id and tree_id is required")
+	}
+	if req.GetForestId() != "" {
+		if _, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleEditor); err != nil {
+			return nil, err
+		}
+		tags, err := updateForest(ctx, req.GetForestId(), req.GetTags())
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		if forestModel, err := s.Store.Forest.GetForest(ctx, req.GetForestId(), false, 0); err == nil {
+			s.publish(ctx, &models.ForestEvent{Type: models.ForestEventForestUpdated, ForestId: forestModel.Id, Forest: forestModel})
+		}
+		return &forest.TagsResponse{Tags: tags}, nil
+	}
+
+	acc, err := s.authorizeTrees(ctx, models.ForestRoleEditor, req.GetTreeId())
+	if err != nil {
+		return nil, err
+	}
+	tags, err := updateTree(ctx, req.GetTreeId(), req.GetTags())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if treeModel, err := s.Store.Forest.GetTreeByID(ctx, req.GetTreeId(), false, 0); err == nil {
+		s.publish(ctx, &models.ForestEvent{Type: models.ForestEventTreeUpdated, ForestId: acc.forestID, TreeId: treeModel.Id, Tree: treeModel})
+	}
+	return &forest.TagsResponse{Tags: tags}, nil
+}
+
+// 호출자가 소유한 숲의 태그와 사용 횟수 조회
+func (s *ForestService) ListTags(ctx context.Context, req *forest.ListTagsRequest) (*forest.ListTagsResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := s.Store.Forest.ListTags(ctx, userID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	tagsProto := make([]*forest.TagCount, len(tags))
+	for i, tag := range tags {
+		tagsProto[i] = tag.ToProto()
+	}
+	return &forest.ListTagsResponse{
+		Tags: tagsProto,
+	}, nil
+}
+
+// 호출자가 소유한 모든 숲에서 태그가 붙은 트리 조회
+func (s *ForestService) FindTreesByTag(ctx context.Context, req *forest.FindTreesByTagRequest) (*forest.FindTreesByTagResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	trees, err := s.Store.Forest.FindTreesByTag(ctx, userID, req.GetTag())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	treesProto := make([]*forest.TaggedTree, len(trees))
+	for i, tree := range trees {
+		treesProto[i] = tree.ToProto()
+	}
+	return &forest.FindTreesByTagResponse{
+		Trees: treesProto,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package forestservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/jdk829355/InForest_back/models"
+	"github.com/jdk829355/InForest_back/protos/forest"
+)
+
+func (s *ForestService) ListTrash(ctx context.Context, req *forest.ListTrashRequest) (*forest.ListTrashResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.Store.Forest.ListTrash(ctx, userID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	itemsProto := make([]*forest.TrashItem, len(items))
+	for i, item := range items {
+		itemsProto[i] = item.ToProto()
+	}
+	return &forest.ListTrashResponse{
+		Items: itemsProto,
+	}, nil
+}
+
+func (s *ForestService) RestoreForest(ctx context.Context, req *forest.RestoreForestRequest) (*forest.Forest, error) {
+	if _, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleOwner); err != nil {
+		return nil, err
+	}
+	forestModel, err := s.Store.Forest.RestoreForest(ctx, req.GetForestId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return forestModel.ToProto(), nil
+}
+
+// 트리를 하위 트리와 함께 복원
+// 부모가 휴지통에 있으면 복원할 수 없음
+func (s *ForestService) RestoreTree(ctx context.Context, req *forest.RestoreTreeRequest) (*forest.Tree, error) {
+	acc, err := s.authorizeTrees(ctx, models.ForestRoleEditor, req.GetTreeId())
+	if err != nil {
+		return nil, err
+	}
+	tree, err := s.Store.Forest.RestoreTree(ctx, req.GetTreeId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventTreeAdded, ForestId: acc.forestID, TreeId: tree.Id, Tree: tree})
+	return tree.ToProto(), nil
+}
+
+// 사용자의 휴지통을 비우고 메모도 함께 영구 삭제
+func (s *ForestService) PurgeTrash(ctx context.Context, req *forest.PurgeTrashRequest) (*forest.PurgeTrashResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	purged, err := s.Store.PurgeTrash(ctx, userID, time.Now())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &forest.PurgeTrashResponse{
+		Purged: int32(purged),
+	}, nil
+}
@@ -8,96 +8,315 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"slices"
+	"time"
 
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/jdk829355/InForest_back/internal/store"
 	"github.com/jdk829355/InForest_back/models"
 	"github.com/jdk829355/InForest_back/protos/forest"
 	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // 메모 적용 완료
 func (s *ForestService) CreateTree(ctx context.Context, req *forest.CreateTreeRequest) (*forest.CreateTreeResponse, error) {
-	user_id := ctx.Value("user_id")
-	if user_id == "" {
-		return nil, errors.New("invalid user_id")
+	acc, err := s.authorizeTrees(ctx, models.ForestRoleEditor, req.GetParentId())
+	if err != nil {
+		return nil, err
 	}
 	treeModel := &models.Tree{
-		Id:   req.GetId(),
-		Name: req.GetName(),
-		Url:  req.GetUrl(),
+		Id:           req.GetId(),
+		Name:         req.GetName(),
+		Url:          req.GetUrl(),
+		CanonicalUrl: s.URLs.Canonicalize(req.GetUrl()),
 	}
-	id, err := s.Store.Neo4j.CreateTree(ctx, treeModel, req.GetParentId())
-	if id == "" || err != nil {
+	// 같은 숲에 같은 페이지의 트리가 있으면 정책에 따라 거절하거나 기존 트리를 반환
+	existing, err := s.duplicateOf(ctx, acc.forestID, treeModel.CanonicalUrl, "", req.GetOnDuplicate())
+	if err != nil {
 		return nil, err
 	}
+	if existing != nil {
+		return s.duplicateTreeResponse(ctx, acc, existing)
+	}
+	index := -1
+	if req.Position != nil {
+		index = int(req.GetPosition())
+	}
+	id, err := s.Store.Forest.CreateTree(ctx, treeModel, req.GetParentId(), index)
+	if id == "" || err != nil {
+		return nil, toStatus(err)
+	}
 	// 트리 생성 후 해당 메모 생성
-	memo, err := s.Store.Supabase.CreateMemo(user_id.(string), id, nil)
+	memo, err := s.Store.Memo.CreateMemo(ctx, acc.ownerID, id, nil)
 	if err != nil {
-		_, _ = s.Store.Neo4j.DeleteTree(ctx, id, true)
+		_, _ = s.Store.Forest.PurgeTree(ctx, id)
 		return nil, err
 	}
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventTreeAdded, ForestId: acc.forestID, TreeId: id, ParentId: req.GetParentId(), Tree: treeModel})
 	return &forest.CreateTreeResponse{
 		Tree: treeModel.ToProto(),
 		Memo: memo.ToProto(),
 	}, nil
 }
 
+// duplicateOf는 정책이 ALLOW가 아니면 숲에서 정규화한 url이 canonicalURL인 트리를 찾습니다. (selfID인 트리는 제외)
+// REJECT면 AlreadyExists를, MERGE면 찾은 트리를 반환하며 중복이 없으면 nil을 반환합니다.
+func (s *ForestService) duplicateOf(ctx context.Context, forestID string, canonicalURL string, selfID string, policy forest.DuplicatePolicy) (*models.Tree, error) {
+	if policy == forest.DuplicatePolicy_DUPLICATE_POLICY_ALLOW || canonicalURL == "" {
+		return nil, nil
+	}
+	existing, err := s.Store.Forest.FindDuplicateTree(ctx, forestID, canonicalURL)
+	switch {
+	case errors.Is(err, store.ErrTreeNotFound):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	case existing.Id == selfID:
+		// 이미 같은 url을 가진 트리를 다시 저장하는 경우이므로 새로 생기는 중복이 없음
+		return nil, nil
+	case policy == forest.DuplicatePolicy_DUPLICATE_POLICY_MERGE:
+		return existing, nil
+	}
+	return nil, status.Errorf(codes.AlreadyExists, "tree %s already has url %s in this forest", existing.Id, canonicalURL)
+}
+
+// duplicateTreeResponse는 새 트리 대신 반환할 기존 트리와 그 메모로 응답을 만듭니다.
+func (s *ForestService) duplicateTreeResponse(ctx context.Context, acc *access, existing *models.Tree) (*forest.CreateTreeResponse, error) {
+	memo, err := s.Store.Memo.GetMemo(ctx, acc.ownerID, existing.Id)
+	if err != nil && !errors.Is(err, store.ErrMemoNotFound) {
+		return nil, err
+	}
+	resp := &forest.CreateTreeResponse{
+		Tree:      existing.ToProto(),
+		Duplicate: true,
+	}
+	if memo != nil {
+		resp.Memo = memo.ToProto()
+	}
+	return resp, nil
+}
+
 func (s *ForestService) GetTree(ctx context.Context, req *forest.GetTreeRequest) (*forest.Tree, error) {
-	tree, err := s.Store.Neo4j.GetTreeByID(ctx, req.GetTreeId(), req.GetIncludeChildren())
-	if err != nil {
+	if _, err := s.authorizeTrees(ctx, models.ForestRoleViewer, req.GetTreeId()); err != nil {
 		return nil, err
 	}
+	tree, err := s.Store.Forest.GetTreeByID(ctx, req.GetTreeId(), req.GetIncludeChildren(), int(req.GetMaxDepth()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
 	return tree.ToProto(), nil
 }
 
+// 직계 자식만 페이지 단위로 조회 (큰 숲을 한 단계씩 펼치기 위함)
+func (s *ForestService) ListChildren(ctx context.Context, req *forest.ListChildrenRequest) (*forest.ListChildrenResponse, error) {
+	if _, err := s.authorizeTrees(ctx, models.ForestRoleViewer, req.GetParentId()); err != nil {
+		return nil, err
+	}
+	children, nextPageToken, err := s.Store.Forest.ListChildren(ctx, req.GetParentId(), int(req.GetPageSize()), req.GetPageToken())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	childrenProto := make([]*forest.Tree, len(children))
+	for i, child := range children {
+		childrenProto[i] = child.ToProto()
+	}
+	return &forest.ListChildrenResponse{
+		Children:      childrenProto,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 func (s *ForestService) UpdateTree(ctx context.Context, req *forest.UpdateTreeRequest) (*forest.Tree, error) {
+	if req.GetTreeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "tree_id is required")
+	}
+	acc, err := s.authorizeTrees(ctx, models.ForestRoleEditor, req.GetTreeId())
+	if err != nil {
+		return nil, err
+	}
 	inputTreeModel := &models.Tree{
-		Id:   req.GetTreeId(),
-		Name: req.GetName(),
-		Url:  req.GetUrl(),
+		Id:           req.GetTreeId(),
+		Name:         req.GetName(),
+		Url:          req.GetUrl(),
+		CanonicalUrl: s.URLs.Canonicalize(req.GetUrl()),
+	}
+	// update_mask가 없으면 비어 있지 않은 필드만 업데이트
+	fields := req.GetUpdateMask().GetPaths()
+	if req.GetUpdateMask() == nil {
+		if req.GetName() != "" {
+			fields = append(fields, "name")
+		}
+		if req.GetUrl() != "" {
+			fields = append(fields, "url")
+		}
 	}
-	treeModel, err := s.Store.Neo4j.UpdateTree(ctx, inputTreeModel)
+	// url을 바꾸면 트리 생성과 같은 중복 정책 적용 (MERGE면 바꾸지 않고 기존 트리 반환)
+	if slices.Contains(fields, "url") {
+		existing, err := s.duplicateOf(ctx, acc.forestID, inputTreeModel.CanonicalUrl, req.GetTreeId(), req.GetOnDuplicate())
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return existing.ToProto(), nil
+		}
+	}
+	treeModel, err := s.Store.Forest.UpdateTree(ctx, inputTreeModel, fields)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventTreeUpdated, ForestId: acc.forestID, TreeId: treeModel.Id, Tree: &treeModel})
+	return treeModel.ToProto(), nil
+}
+
+// 클라이언트와 서버의 시계 차이로 허용하는 visited_at의 최대 미래 시각
+const maxVisitClockSkew = 5 * time.Minute
+
+// 트리 페이지 방문을 기록하고 방문 집계가 갱신된 트리를 반환
+// 확장 프로그램이 페이지를 떠날 때 호출하며, 늦게 도착한 방문 기록도 시각 기준으로 집계됨
+func (s *ForestService) RecordVisit(ctx context.Context, req *forest.RecordVisitRequest) (*forest.Tree, error) {
+	acc, err := s.authorizeTrees(ctx, models.ForestRoleEditor, req.GetTreeId())
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now()
+	visitedAt := now
+	if req.GetVisitedAt() != "" {
+		if visitedAt, err = time.Parse(time.RFC3339, req.GetVisitedAt()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "visited_at must be RFC3339")
+		}
+		if visitedAt.After(now.Add(maxVisitClockSkew)) {
+			return nil, status.Error(codes.InvalidArgument, "visited_at must not be in the future")
+		}
+	}
+	// 저장소는 시각을 시간대 이름과 함께 보내므로 오프셋이 있는 시각도 UTC로 맞춤
+	visitedAt = visitedAt.UTC()
+	treeModel, err := s.Store.Forest.RecordVisit(ctx, &models.Visit{
+		TreeId:    req.GetTreeId(),
+		UserId:    acc.userID,
+		VisitedAt: visitedAt,
+		DwellMs:   req.GetDwellMs(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventTreeUpdated, ForestId: acc.forestID, TreeId: treeModel.Id, Tree: treeModel})
 	return treeModel.ToProto(), nil
 }
 
-// 트리 삭제 시 메모도 같이 삭제
-// TODO 리프 트리 삭제 시 에러
-// cascade: true일 때 interface conversion: interface {} is nil, not string
-// cascade: false일 때 tree not found 에러
+// 트리를 휴지통으로 옮김
+// 메모는 영구 삭제될 때까지 유지됨
 func (s *ForestService) DeleteTree(ctx context.Context, req *forest.DeleteTreeRequest) (*forest.DeleteTreeResponse, error) {
-	user_id := ctx.Value("user_id")
-	if user_id == "" {
-		return nil, errors.New("invalid user_id")
-	}
-	deletedIds, err := s.Store.Neo4j.DeleteTree(ctx, req.GetTreeId(), req.GetCascade())
+	acc, err := s.authorizeTrees(ctx, models.ForestRoleEditor, req.GetTreeId())
 	if err != nil {
+		return nil, err
+	}
+	if _, err := s.Store.Forest.DeleteTree(ctx, req.GetTreeId(), req.GetCascade()); err != nil {
 		return &forest.DeleteTreeResponse{
 			Success: false,
-		}, err
-	}
-	deletedMemos := map[string]models.Memo{}
-	for _, treeID := range deletedIds {
-		memo, err := s.Store.Supabase.DeleteMemo(user_id.(string), treeID)
-		if err != nil {
-			for _, m := range deletedMemos {
-				// 롤백: 삭제된 메모 복구
-				_, _ = s.Store.Supabase.CreateMemo(m.UserID, m.TreeID, map[string]interface{}{
-					"content": m.Content,
-					"version": m.Version,
-				})
-			}
-			return nil, err
-		} else {
-			deletedMemos[memo.TreeID] = *memo
-		}
+		}, toStatus(err)
 	}
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventTreeDeleted, ForestId: acc.forestID, TreeId: req.GetTreeId()})
 	return &forest.DeleteTreeResponse{
 		Success: true,
 	}, nil
 }
 
+// 트리를 하위 트리와 함께 다른 부모 아래로 이동 (다른 숲으로의 이동 포함)
+// 메모는 트리 ID 기준으로 저장되므로 별도 처리가 필요 없음
+func (s *ForestService) MoveTree(ctx context.Context, req *forest.MoveTreeRequest) (*forest.Tree, error) {
+	src, err := s.authorizeTrees(ctx, models.ForestRoleEditor, req.GetTreeId())
+	if err != nil {
+		return nil, err
+	}
+	dst, err := s.authorizeTrees(ctx, models.ForestRoleEditor, req.GetNewParentId())
+	if err != nil {
+		return nil, err
+	}
+	if src.ownerID != dst.ownerID {
+		return nil, toStatus(store.ErrForestOwnerMismatch)
+	}
+	tree, err := s.Store.Forest.MoveTree(ctx, req.GetTreeId(), req.GetNewParentId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	// 다른 숲으로 옮긴 경우 원래 숲에서는 삭제된 것으로 알림
+	if src.forestID != dst.forestID {
+		s.publish(ctx, &models.ForestEvent{Type: models.ForestEventTreeDeleted, ForestId: src.forestID, TreeId: tree.Id})
+	}
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventTreeMoved, ForestId: dst.forestID, TreeId: tree.Id, ParentId: req.GetNewParentId(), Tree: tree})
+	return tree.ToProto(), nil
+}
+
+// 부모 트리의 자식 순서를 요청한 순서로 변경
+func (s *ForestService) ReorderChildren(ctx context.Context, req *forest.ReorderChildrenRequest) (*forest.Tree, error) {
+	acc, err := s.authorizeTrees(ctx, models.ForestRoleEditor, req.GetParentId())
+	if err != nil {
+		return nil, err
+	}
+	tree, err := s.Store.Forest.ReorderChildren(ctx, req.GetParentId(), req.GetOrderedChildIds())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventChildrenReordered, ForestId: acc.forestID, TreeId: tree.Id, Tree: tree})
+	return tree.ToProto(), nil
+}
+
+// 하위 트리를 복사해 다른 트리 아래에 붙임
+// 복사된 트리마다 메모를 만들고, include_memos면 원본 메모 내용을 복사함
+func (s *ForestService) CopyTree(ctx context.Context, req *forest.CopyTreeRequest) (*forest.Tree, error) {
+	acc, err := s.authorizeTrees(ctx, models.ForestRoleEditor, req.GetTreeId(), req.GetTargetParentId())
+	if err != nil {
+		return nil, err
+	}
+	copied, idMap, err := s.Store.Forest.CopyTree(ctx, req.GetTreeId(), req.GetTargetParentId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if err := s.copyMemos(ctx, acc.ownerID, idMap, req.GetIncludeMemos()); err != nil {
+		// 롤백: 복사된 트리 영구 삭제
+		_, _ = s.Store.Forest.PurgeTree(ctx, copied.Id)
+		return nil, err
+	}
+	s.publish(ctx, &models.ForestEvent{Type: models.ForestEventTreeAdded, ForestId: acc.forestID, TreeId: copied.Id, ParentId: req.GetTargetParentId(), Tree: copied})
+	return copied.ToProto(), nil
+}
+
+// copyMemos는 복사된 트리들의 메모를 생성합니다.
+// 실패하면 이미 생성한 메모를 삭제하고 에러를 반환합니다.
+func (s *ForestService) copyMemos(ctx context.Context, user_id string, idMap map[string]string, includeMemos bool) error {
+	created := []string{}
+	for oldID, newID := range idMap {
+		var options map[string]interface{}
+		if includeMemos {
+			memo, err := s.Store.Memo.GetMemo(ctx, user_id, oldID)
+			if err != nil && !errors.Is(err, store.ErrMemoNotFound) {
+				return rollbackMemos(ctx, s.Store.Memo, user_id, created, err)
+			}
+			if memo != nil {
+				options = map[string]interface{}{
+					"content": memo.Content,
+					"version": int32(0),
+				}
+			}
+		}
+		if _, err := s.Store.Memo.CreateMemo(ctx, user_id, newID, options); err != nil {
+			return rollbackMemos(ctx, s.Store.Memo, user_id, created, err)
+		}
+		created = append(created, newID)
+	}
+	return nil
+}
+
+func rollbackMemos(ctx context.Context, memos store.MemoStore, user_id string, treeIDs []string, cause error) error {
+	for _, treeID := range treeIDs {
+		_, _ = memos.DeleteMemo(ctx, user_id, treeID)
+	}
+	return cause
+}
+
 func (s *ForestService) GetSummary(req *forest.GetSummaryRequest, stream forest.ForestService_GetSummaryServer) error {
 	// 트리의 요약 조회 후 없으면 스트리밍 생성
 	// 요약 생성 중간중간 진행상황 스트리밍
@@ -105,8 +324,11 @@ func (s *ForestService) GetSummary(req *forest.GetSummaryRequest, stream forest.
 	// 요약이 없는 경우 FastAPI 호출하여 요약 생성
 	// 생성된 요약을 스트리밍으로 반환
 	// 중복 요청 시 기존 요약 생성 작업에 합류하여 스트리밍으로 반환
+	if _, err := s.authorizeTrees(stream.Context(), models.ForestRoleViewer, req.GetTreeId()); err != nil {
+		return err
+	}
 	tree := &models.Tree{}
-	tree, err := s.Store.Neo4j.GetTreeByID(stream.Context(), req.GetTreeId(), false)
+	tree, err := s.Store.Forest.GetTreeByID(stream.Context(), req.GetTreeId(), false, 0)
 	if err != nil {
 		return errors.New("failed to get tree: " + err.Error())
 	}
@@ -173,7 +395,7 @@ func (s *ForestService) streamStatus(stream *forest.ForestService_GetSummaryServ
 		switch msg.Payload {
 		case "COMPLETED":
 			tree := &models.Tree{}
-			tree, err := s.Store.Neo4j.GetTreeByID(ctx, tree_id, false)
+			tree, err := s.Store.Forest.GetTreeByID(ctx, tree_id, false, 0)
 			if err != nil {
 				return err
 			}
@@ -206,6 +428,63 @@ func (s *ForestService) streamStatus(stream *forest.ForestService_GetSummaryServ
 	}
 }
 
+// 요약 완료 이벤트를 한 번만 보내기 위해 잡아 두는 키의 유지 시간
+const summaryEventClaimTTL = time.Minute
+
+// 요약 작업이 끝나기를 서버에서 기다리는 최대 시간
+const summaryWatchTimeout = 10 * time.Minute
+
+// watchSummaryTask는 GetSummary 스트림과 관계없이 요약 작업 채널을 구독해, 작업이 완료되면 summary_completed 이벤트를 보냅니다.
+// 작업을 요청하기 전에 호출해야 완료 메시지를 놓치지 않으므로 구독이 확인된 뒤 반환하고, 기다리는 것은 백그라운드에서 합니다.
+// 작업 요청이 실패하면 반환된 함수로 기다리기를 멈춥니다.
+func (s *ForestService) watchSummaryTask(ctx context.Context, rdb *redis.Client, treeID string) (func(), error) {
+	// 요청한 클라이언트가 연결을 끊어도 이벤트는 보내야 하므로 취소되지 않는 컨텍스트 사용
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), summaryWatchTimeout)
+	pubsub := rdb.Subscribe(ctx, treeID)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		cancel()
+		return nil, err
+	}
+	go func() {
+		defer cancel()
+		defer pubsub.Close()
+		logger := ctxzap.Extract(ctx)
+		for {
+			msg, err := pubsub.ReceiveMessage(ctx)
+			if err != nil {
+				logger.Warn("Stopped waiting for summary task", zap.String("tree_id", treeID), zap.Error(err))
+				return
+			}
+			switch msg.Payload {
+			case "COMPLETED":
+				tree, err := s.Store.Forest.GetTreeByID(ctx, treeID, false, 0)
+				if err != nil {
+					logger.Warn("Failed to read completed summary", zap.String("tree_id", treeID), zap.Error(err))
+					return
+				}
+				s.publishSummaryCompleted(ctx, rdb, tree)
+				return
+			case "FAILED":
+				return
+			}
+		}
+	}()
+	return cancel, nil
+}
+
+// publishSummaryCompleted는 요약이 저장된 뒤 summary_completed 이벤트를 보냅니다.
+// 같은 트리의 작업을 여러 서버가 기다리고 있어도 키를 먼저 잡은 쪽만 이벤트를 보냅니다.
+func (s *ForestService) publishSummaryCompleted(ctx context.Context, rdb *redis.Client, tree *models.Tree) {
+	claimed, err := rdb.SetNX(ctx, fmt.Sprintf("summary_event:%s", tree.Id), 1, summaryEventClaimTTL).Result()
+	if err != nil || !claimed {
+		return
+	}
+	if forestID, _, err := s.Store.Forest.GetTreeOwner(ctx, tree.Id); err == nil {
+		s.publish(ctx, &models.ForestEvent{Type: models.ForestEventSummaryCompleted, ForestId: forestID, TreeId: tree.Id, Tree: tree})
+	}
+}
+
 func (s *ForestService) startNewSummaryTask(tree *models.Tree, rdb *redis.Client, stream forest.ForestService_GetSummaryServer) error {
 	body, err := json.Marshal(SummaryRequest{
 		TreeID: tree.Id,
@@ -214,6 +493,11 @@ func (s *ForestService) startNewSummaryTask(tree *models.Tree, rdb *redis.Client
 	if err != nil {
 		return err
 	}
+	// GetSummary 스트림이 없어도 WatchForest 구독자가 완료 이벤트를 받도록 서버에서 작업 완료를 기다림
+	stopWatching, err := s.watchSummaryTask(stream.Context(), rdb, tree.Id)
+	if err != nil {
+		return err
+	}
 	// TODO 하드코딩된 ai_app:8000 환경변수로 빼기
 	// TODO http 클라이언트 재사용 고려 (코드도 중복됨 없앨 필요 있음)
 	aiServiceURL := os.Getenv("AI_SERVICE_URL")
@@ -222,16 +506,19 @@ func (s *ForestService) startNewSummaryTask(tree *models.Tree, rdb *redis.Client
 	}
 	newTaskreq, err := http.NewRequest(http.MethodPost, aiServiceURL+"/task", bytes.NewBuffer(body))
 	if err != nil {
+		stopWatching()
 		return err
 	}
 	newTaskreq.Header.Set("Content-Type", "application/json")
 	client := &http.Client{}
 	resp, err := client.Do(newTaskreq)
 	if err != nil {
+		stopWatching()
 		return err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
+		stopWatching()
 		return fmt.Errorf("failed to start summary task, status code: %d", resp.StatusCode)
 	}
 	// TODO 해당 코드 중복 너무 많음.. 리팩토링 필요
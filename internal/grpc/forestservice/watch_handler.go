@@ -0,0 +1,84 @@
+package forestservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/jdk829355/InForest_back/models"
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 멤버 변경 이벤트를 놓친 경우에도 권한을 다시 확인하는 주기
+const watchAccessRecheckInterval = time.Minute
+
+// 숲의 변경 이벤트를 스트리밍 (viewer 이상 가능)
+// 클라이언트가 연결을 끊을 때까지 유지되며, 멤버 변경 이벤트를 받거나 주기적으로 권한을 다시 확인해
+// 권한을 잃으면 PermissionDenied로 종료됨
+func (s *ForestService) WatchForest(req *forest.WatchForestRequest, stream forest.ForestService_WatchForestServer) error {
+	ctx := stream.Context()
+	if _, err := s.authorizeForest(ctx, req.GetForestId(), models.ForestRoleViewer); err != nil {
+		return err
+	}
+	events, cancel, err := s.Events.Subscribe(ctx, req.GetForestId())
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	ticker := time.NewTicker(watchAccessRecheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.recheckWatchAccess(ctx, req.GetForestId()); err != nil {
+				return err
+			}
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if event.Type == models.ForestEventMembersChanged {
+				if err := s.recheckWatchAccess(ctx, req.GetForestId()); err != nil {
+					return err
+				}
+			}
+			if err := stream.Send(event.ToProto()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// recheckWatchAccess는 구독 중인 호출자가 아직 숲을 볼 수 있는지 확인합니다.
+// 멤버에서 빠졌거나 숲이 삭제되었으면 PermissionDenied를 반환합니다.
+func (s *ForestService) recheckWatchAccess(ctx context.Context, forestID string) error {
+	_, err := s.authorizeForest(ctx, forestID, models.ForestRoleViewer)
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.PermissionDenied, codes.NotFound:
+		return status.Error(codes.PermissionDenied, "access to forest was revoked")
+	}
+	return err
+}
+
+// publish는 변경이 저장된 뒤 이벤트를 발행합니다.
+// 변경은 이미 반영되었으므로 발행에 실패해도 요청을 실패시키지 않고 로그만 남깁니다.
+func (s *ForestService) publish(ctx context.Context, event *models.ForestEvent) {
+	if s.Events == nil {
+		return
+	}
+	event.OccurredAt = time.Now().UTC()
+	if userID, err := callerID(ctx); err == nil {
+		event.UserId = userID
+	}
+	if err := s.Events.Publish(ctx, event); err != nil {
+		ctxzap.Extract(ctx).Warn("Failed to publish forest event", zap.String("forest_id", event.ForestId), zap.String("type", string(event.Type)), zap.Error(err))
+	}
+}
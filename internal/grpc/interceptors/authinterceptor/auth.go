@@ -20,19 +20,31 @@ type (
 	}
 
 	authInterceptor struct {
-		validator Validator
+		validator     Validator
+		publicMethods map[string]bool
 	}
 )
 
-func NewAuthInterceptor(validator Validator) (*authInterceptor, error) {
+// NewAuthInterceptor creates the interceptor. publicMethods are full method
+// names (e.g. "/ForestService/GetSharedForest") that are called without a token.
+func NewAuthInterceptor(validator Validator, publicMethods ...string) (*authInterceptor, error) {
 	if validator == nil {
 		return nil, errors.New("validator cannot be nil")
 	}
-	return &authInterceptor{validator: validator}, nil
+	public := make(map[string]bool, len(publicMethods))
+	for _, method := range publicMethods {
+		public[method] = true
+	}
+	return &authInterceptor{validator: validator, publicMethods: public}, nil
 }
 
 func (i *authInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		// public methods don't need a token
+		if i.publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
 		// get metadata object
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
@@ -62,6 +74,10 @@ func (i *authInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 
 func (i *authInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
 	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if i.publicMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+
 		md, ok := metadata.FromIncomingContext(ss.Context())
 		if !ok {
 			return status.Error(codes.Unauthenticated, "metadata is not provided")
@@ -0,0 +1,25 @@
+package events
+
+import (
+	"context"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+// Broker는 숲 변경 이벤트를 구독자에게 전달합니다.
+// MemoryBroker는 단일 서버와 테스트용, RedisBroker는 여러 서버 간 전달용입니다.
+type Broker interface {
+	// Publish는 이벤트를 event.ForestId를 구독 중인 모든 구독자에게 보냅니다.
+	Publish(ctx context.Context, event *models.ForestEvent) error
+	// Subscribe는 forestID의 이벤트를 받는 채널을 반환합니다.
+	// ctx가 취소되거나 cancel을 호출하면 구독이 끝나고 채널이 닫힙니다.
+	Subscribe(ctx context.Context, forestID string) (events <-chan *models.ForestEvent, cancel func(), err error)
+}
+
+// 구독자 채널 버퍼 크기. 구독자가 처리하지 못해 버퍼가 가득 차면 이벤트를 버림
+const subscriberBuffer = 64
+
+var (
+	_ Broker = (*MemoryBroker)(nil)
+	_ Broker = (*RedisBroker)(nil)
+)
@@ -0,0 +1,67 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+// MemoryBroker는 한 프로세스 안에서만 이벤트를 전달하는 Broker입니다.
+type MemoryBroker struct {
+	mu          sync.Mutex
+	subscribers map[string]map[*memorySubscriber]struct{} // 숲 ID → 구독자
+}
+
+type memorySubscriber struct {
+	events chan *models.ForestEvent
+	once   sync.Once
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		subscribers: map[string]map[*memorySubscriber]struct{}{},
+	}
+}
+
+func (b *MemoryBroker) Publish(ctx context.Context, event *models.ForestEvent) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subscribers[event.ForestId] {
+		select {
+		case sub.events <- event:
+		default:
+			// 느린 구독자 때문에 발행이 막히지 않도록 버림
+		}
+	}
+	return nil
+}
+
+func (b *MemoryBroker) Subscribe(ctx context.Context, forestID string) (<-chan *models.ForestEvent, func(), error) {
+	sub := &memorySubscriber{events: make(chan *models.ForestEvent, subscriberBuffer)}
+
+	b.mu.Lock()
+	if b.subscribers[forestID] == nil {
+		b.subscribers[forestID] = map[*memorySubscriber]struct{}{}
+	}
+	b.subscribers[forestID][sub] = struct{}{}
+	b.mu.Unlock()
+
+	cancel := func() {
+		sub.once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			delete(b.subscribers[forestID], sub)
+			if len(b.subscribers[forestID]) == 0 {
+				delete(b.subscribers, forestID)
+			}
+			close(sub.events)
+		})
+	}
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+	return sub.events, cancel, nil
+}
@@ -0,0 +1,68 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jdk829355/InForest_back/models"
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisBroker는 Redis pub/sub으로 여러 서버 간에 이벤트를 전달하는 Broker입니다.
+// 숲마다 "forest_events:<숲 ID>" 채널을 사용합니다.
+type RedisBroker struct {
+	rdb *redis.Client
+}
+
+func NewRedisBroker(rdb *redis.Client) *RedisBroker {
+	return &RedisBroker{rdb: rdb}
+}
+
+func channelOf(forestID string) string {
+	return fmt.Sprintf("forest_events:%s", forestID)
+}
+
+func (b *RedisBroker) Publish(ctx context.Context, event *models.ForestEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return b.rdb.Publish(ctx, channelOf(event.ForestId), payload).Err()
+}
+
+func (b *RedisBroker) Subscribe(ctx context.Context, forestID string) (<-chan *models.ForestEvent, func(), error) {
+	pubsub := b.rdb.Subscribe(ctx, channelOf(forestID))
+	// 구독이 등록된 뒤에 반환해야 그 이후 발행된 이벤트를 놓치지 않음
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	events := make(chan *models.ForestEvent, subscriberBuffer)
+	go func() {
+		defer close(events)
+		defer pubsub.Close()
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				event := &models.ForestEvent{}
+				if err := json.Unmarshal([]byte(msg.Payload), event); err != nil {
+					continue
+				}
+				select {
+				case events <- event:
+				default:
+				}
+			}
+		}
+	}()
+	return events, cancel, nil
+}
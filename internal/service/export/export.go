@@ -0,0 +1,60 @@
+package export
+
+import (
+	"bufio"
+	"errors"
+	"io"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+// Format은 숲을 내보낼 파일 형식입니다.
+type Format string
+
+const (
+	FormatJSON     Format = "json"
+	FormatMarkdown Format = "markdown"
+	FormatOPML     Format = "opml"
+	FormatNetscape Format = "netscape" // 브라우저에서 가져올 수 있는 Netscape 북마크 HTML
+)
+
+// ErrUnknownFormat은 지원하지 않는 형식으로 내보내려 할 때 반환됩니다.
+var ErrUnknownFormat = errors.New("unknown export format")
+
+type formatInfo struct {
+	contentType string
+	extension   string
+	write       func(w *bufio.Writer, forest *models.Forest, memos map[string]string) error
+}
+
+var formats = map[Format]formatInfo{
+	FormatJSON:     {"application/json", ".json", writeJSON},
+	FormatMarkdown: {"text/markdown; charset=utf-8", ".md", writeMarkdown},
+	FormatOPML:     {"text/x-opml; charset=utf-8", ".opml", writeOPML},
+	FormatNetscape: {"text/html; charset=utf-8", ".html", writeNetscape},
+}
+
+// ContentType은 형식의 MIME 타입을 반환합니다. 지원하지 않는 형식이면 ""를 반환합니다.
+func (f Format) ContentType() string {
+	return formats[f].contentType
+}
+
+// Extension은 형식의 파일 확장자(.json 등)를 반환합니다. 지원하지 않는 형식이면 ""를 반환합니다.
+func (f Format) Extension() string {
+	return formats[f].extension
+}
+
+// Write는 forest.Root의 하위 트리 전체를 format 형식으로 w에 씁니다.
+// memos는 트리 ID → 메모 내용이며, nil이면 메모를 포함하지 않습니다.
+// 같은 입력이면 항상 같은 바이트를 씁니다.
+func Write(w io.Writer, format Format, forest *models.Forest, memos map[string]string) error {
+	info, ok := formats[format]
+	if !ok {
+		return ErrUnknownFormat
+	}
+	bw := bufio.NewWriter(w)
+	if err := info.write(bw, forest, memos); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
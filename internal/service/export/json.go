@@ -0,0 +1,104 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"time"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+// jsonSchemaVersion은 JSON 내보내기 형식의 버전입니다. 필드를 바꾸거나 없애면 올립니다.
+const jsonSchemaVersion = 1
+
+type jsonDocument struct {
+	Version int         `json:"version"`
+	Forest  *jsonForest `json:"forest"`
+}
+
+type jsonForest struct {
+	Id          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Tags        []string  `json:"tags"`
+	CreatedAt   string    `json:"created_at,omitempty"`
+	UpdatedAt   string    `json:"updated_at,omitempty"`
+	Root        *jsonTree `json:"root"`
+}
+
+type jsonTree struct {
+	Id             string      `json:"id"`
+	Name           string      `json:"name"`
+	Url            string      `json:"url"`
+	Summary        string      `json:"summary"`
+	Memo           *string     `json:"memo,omitempty"` // 메모를 포함하지 않으면 생략
+	Tags           []string    `json:"tags"`
+	CreatedAt      string      `json:"created_at,omitempty"`
+	UpdatedAt      string      `json:"updated_at,omitempty"`
+	FirstVisitedAt string      `json:"first_visited_at,omitempty"`
+	LastVisitedAt  string      `json:"last_visited_at,omitempty"`
+	VisitCount     int32       `json:"visit_count"`
+	TotalDwellMs   int64       `json:"total_dwell_ms"`
+	Children       []*jsonTree `json:"children"`
+}
+
+func writeJSON(w *bufio.Writer, forest *models.Forest, memos map[string]string) error {
+	doc := jsonDocument{
+		Version: jsonSchemaVersion,
+		Forest: &jsonForest{
+			Id:          forest.Id,
+			Name:        forest.Name,
+			Description: forest.Description,
+			Tags:        nonNil(forest.Tags),
+			CreatedAt:   formatTime(forest.CreatedAt),
+			UpdatedAt:   formatTime(forest.UpdatedAt),
+			Root:        newJSONTree(forest.Root, memos),
+		},
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(doc)
+}
+
+func newJSONTree(tree *models.Tree, memos map[string]string) *jsonTree {
+	if tree == nil {
+		return nil
+	}
+	t := &jsonTree{
+		Id:             tree.Id,
+		Name:           tree.Name,
+		Url:            tree.Url,
+		Summary:        tree.Summary,
+		Tags:           nonNil(tree.Tags),
+		CreatedAt:      formatTime(tree.CreatedAt),
+		UpdatedAt:      formatTime(tree.UpdatedAt),
+		FirstVisitedAt: formatTime(tree.FirstVisitedAt),
+		LastVisitedAt:  formatTime(tree.LastVisitedAt),
+		VisitCount:     tree.VisitCount,
+		TotalDwellMs:   tree.TotalDwellMs,
+		Children:       []*jsonTree{},
+	}
+	if memos != nil {
+		memo := memos[tree.Id]
+		t.Memo = &memo
+	}
+	for _, child := range tree.Children {
+		t.Children = append(t.Children, newJSONTree(child, memos))
+	}
+	return t
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,62 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+var (
+	markdownTextEscaper = strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`, `*`, `\*`, `_`, `\_`, "`", "\\`", `<`, `&lt;`)
+	markdownURLEscaper  = strings.NewReplacer(`(`, `%28`, `)`, `%29`, ` `, `%20`, `<`, `%3C`, `>`, `%3E`)
+)
+
+// writeMarkdown은 숲을 중첩 목록으로 씁니다. 요약과 메모는 항목 아래 인용문으로 씁니다.
+func writeMarkdown(w *bufio.Writer, forest *models.Forest, memos map[string]string) error {
+	fmt.Fprintf(w, "# %s\n\n", markdownText(forest.Name))
+	if forest.Description != "" {
+		fmt.Fprintf(w, "%s\n\n", markdownText(forest.Description))
+	}
+	if len(forest.Tags) > 0 {
+		fmt.Fprintf(w, "Tags: %s\n\n", markdownText(strings.Join(forest.Tags, ", ")))
+	}
+	if forest.Root != nil {
+		writeMarkdownTree(w, forest.Root, memos, 0)
+	}
+	return nil
+}
+
+func writeMarkdownTree(w *bufio.Writer, tree *models.Tree, memos map[string]string, depth int) {
+	indent := strings.Repeat("  ", depth)
+	name := markdownText(tree.Name)
+	if tree.Url != "" {
+		name = fmt.Sprintf("[%s](%s)", name, markdownURLEscaper.Replace(tree.Url))
+	}
+	fmt.Fprintf(w, "%s- %s\n", indent, name)
+
+	quote := indent + "  >"
+	var paragraphs []string
+	if tree.Summary != "" {
+		paragraphs = append(paragraphs, markdownText(tree.Summary))
+	}
+	if memo := memos[tree.Id]; memo != "" {
+		paragraphs = append(paragraphs, "**Memo:** "+markdownText(memo))
+	}
+	for i, paragraph := range paragraphs {
+		if i > 0 {
+			fmt.Fprintf(w, "%s\n", quote)
+		}
+		for _, line := range strings.Split(paragraph, "\n") {
+			fmt.Fprintf(w, "%s %s\n", quote, line)
+		}
+	}
+	for _, child := range tree.Children {
+		writeMarkdownTree(w, child, memos, depth+1)
+	}
+}
+
+func markdownText(text string) string {
+	return markdownTextEscaper.Replace(strings.TrimSpace(text))
+}
@@ -0,0 +1,80 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+const netscapeHeader = `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<!-- This is an automatically generated file.
+     It will be read and overwritten.
+     DO NOT EDIT! -->
+<META HTTP-EQUIV="Content-Type" CONTENT="text/html; charset=UTF-8">
+<TITLE>Bookmarks</TITLE>
+<H1>Bookmarks</H1>
+<DL><p>
+`
+
+// writeNetscape는 숲을 브라우저가 가져올 수 있는 Netscape 북마크 HTML로 씁니다.
+// 숲은 폴더 하나가 되며, 북마크는 자식을 가질 수 없으므로 자식이 있는 트리는
+// 자기 자신의 북마크를 첫 항목으로 담은 같은 이름의 폴더가 됩니다.
+func writeNetscape(w *bufio.Writer, forest *models.Forest, memos map[string]string) error {
+	w.WriteString(netscapeHeader)
+	fmt.Fprintf(w, "    <DT><H3%s%s>%s</H3>\n", netscapeDate("ADD_DATE", forest.CreatedAt), netscapeDate("LAST_MODIFIED", forest.UpdatedAt), html.EscapeString(forest.Name))
+	if forest.Description != "" {
+		fmt.Fprintf(w, "    <DD>%s\n", netscapeText(forest.Description))
+	}
+	w.WriteString("    <DL><p>\n")
+	if forest.Root != nil {
+		writeNetscapeTree(w, forest.Root, memos, 2)
+	}
+	w.WriteString("    </DL><p>\n")
+	w.WriteString("</DL><p>\n")
+	return nil
+}
+
+func writeNetscapeTree(w *bufio.Writer, tree *models.Tree, memos map[string]string, depth int) {
+	indent := strings.Repeat("    ", depth)
+	if len(tree.Children) == 0 && tree.Url != "" {
+		writeNetscapeBookmark(w, tree, memos, indent)
+		return
+	}
+	fmt.Fprintf(w, "%s<DT><H3%s%s>%s</H3>\n", indent, netscapeDate("ADD_DATE", tree.CreatedAt), netscapeDate("LAST_MODIFIED", tree.UpdatedAt), html.EscapeString(tree.Name))
+	fmt.Fprintf(w, "%s<DL><p>\n", indent)
+	if tree.Url != "" {
+		writeNetscapeBookmark(w, tree, memos, indent+"    ")
+	}
+	for _, child := range tree.Children {
+		writeNetscapeTree(w, child, memos, depth+1)
+	}
+	fmt.Fprintf(w, "%s</DL><p>\n", indent)
+}
+
+func writeNetscapeBookmark(w *bufio.Writer, tree *models.Tree, memos map[string]string, indent string) {
+	attrs := fmt.Sprintf(` HREF="%s"`, html.EscapeString(tree.Url)) + netscapeDate("ADD_DATE", tree.CreatedAt) + netscapeDate("LAST_VISIT", tree.LastVisitedAt)
+	if len(tree.Tags) > 0 {
+		attrs += fmt.Sprintf(` TAGS="%s"`, html.EscapeString(strings.Join(tree.Tags, ",")))
+	}
+	fmt.Fprintf(w, "%s<DT><A%s>%s</A>\n", indent, attrs, html.EscapeString(tree.Name))
+	if notes := treeNotes(tree, memos); len(notes) > 0 {
+		fmt.Fprintf(w, "%s<DD>%s\n", indent, netscapeText(strings.Join(notes, "\n\n")))
+	}
+}
+
+// netscapeText는 설명 문자열을 이스케이프하고 줄바꿈을 <BR>로 바꿉니다.
+func netscapeText(text string) string {
+	return strings.ReplaceAll(html.EscapeString(text), "\n", "<BR>")
+}
+
+// netscapeDate는 시각을 유닉스 초 단위 속성으로 만듭니다. 0 값이면 속성을 생략합니다.
+func netscapeDate(name string, t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf(` %s="%d"`, name, t.Unix())
+}
@@ -0,0 +1,96 @@
+package export
+
+import (
+	"bufio"
+	"encoding/xml"
+	"strings"
+	"time"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+type opmlDocument struct {
+	XMLName xml.Name     `xml:"opml"`
+	Version string       `xml:"version,attr"`
+	Head    opmlHead     `xml:"head"`
+	Body    opmlOutlines `xml:"body"`
+}
+
+type opmlHead struct {
+	Title        string `xml:"title"`
+	DateCreated  string `xml:"dateCreated,omitempty"`
+	DateModified string `xml:"dateModified,omitempty"`
+}
+
+type opmlOutlines struct {
+	Outlines []*opmlOutline `xml:"outline"`
+}
+
+type opmlOutline struct {
+	Text     string         `xml:"text,attr"`
+	Type     string         `xml:"type,attr,omitempty"`
+	Url      string         `xml:"url,attr,omitempty"`
+	Category string         `xml:"category,attr,omitempty"` // 태그를 쉼표로 연결
+	Note     string         `xml:"_note,attr,omitempty"`    // 요약과 메모
+	Outlines []*opmlOutline `xml:"outline"`
+}
+
+// writeOPML은 숲을 OPML 2.0 문서로 씁니다. URL이 있는 트리는 type="link" outline이 됩니다.
+func writeOPML(w *bufio.Writer, forest *models.Forest, memos map[string]string) error {
+	doc := opmlDocument{
+		Version: "2.0",
+		Head: opmlHead{
+			Title:        forest.Name,
+			DateCreated:  formatRFC822(forest.CreatedAt),
+			DateModified: formatRFC822(forest.UpdatedAt),
+		},
+	}
+	if forest.Root != nil {
+		doc.Body.Outlines = []*opmlOutline{newOPMLOutline(forest.Root, memos)}
+	}
+	if _, err := w.WriteString(xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	_, err := w.WriteString("\n")
+	return err
+}
+
+func newOPMLOutline(tree *models.Tree, memos map[string]string) *opmlOutline {
+	outline := &opmlOutline{
+		Text:     tree.Name,
+		Url:      tree.Url,
+		Category: strings.Join(tree.Tags, ","),
+		Note:     strings.Join(treeNotes(tree, memos), "\n\n"),
+	}
+	if tree.Url != "" {
+		outline.Type = "link"
+	}
+	for _, child := range tree.Children {
+		outline.Outlines = append(outline.Outlines, newOPMLOutline(child, memos))
+	}
+	return outline
+}
+
+// treeNotes는 트리의 요약과 메모 중 비어 있지 않은 것을 순서대로 반환합니다.
+func treeNotes(tree *models.Tree, memos map[string]string) []string {
+	var notes []string
+	if tree.Summary != "" {
+		notes = append(notes, tree.Summary)
+	}
+	if memo := memos[tree.Id]; memo != "" {
+		notes = append(notes, memo)
+	}
+	return notes
+}
+
+func formatRFC822(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC1123Z)
+}
@@ -0,0 +1,209 @@
+package history
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jdk829355/InForest_back/internal/service/urlcanon"
+	"github.com/jdk829355/InForest_back/models"
+)
+
+// DefaultSessionGap은 이 시간보다 오래 방문이 없으면 새 세션(숲)으로 나누는 기본값입니다.
+const DefaultSessionGap = 30 * time.Minute
+
+// Transition은 페이지로 이동한 방식입니다. Chrome 방문 기록의 transition 값을 따릅니다.
+type Transition string
+
+const (
+	TransitionUnknown    Transition = "" // 링크로 취급
+	TransitionLink       Transition = "link"
+	TransitionTyped      Transition = "typed"
+	TransitionBookmark   Transition = "auto_bookmark"
+	TransitionGenerated  Transition = "generated" // 주소창 검색 등
+	TransitionFormSubmit Transition = "form_submit"
+	TransitionReload     Transition = "reload"
+	TransitionSubframe   Transition = "subframe"
+)
+
+// 건너뛴 이유
+const (
+	ReasonInvalidURL   = "invalid url"
+	ReasonInvalidTime  = "invalid visited_at"
+	ReasonInvalidDwell = "negative dwell_ms"
+	ReasonSubframe     = "subframe navigation"
+)
+
+// Record는 방문 기록 내보내기의 한 행입니다.
+type Record struct {
+	Index      int // 가져온 순서 (0부터)
+	Url        string
+	Title      string
+	VisitedAt  time.Time
+	Referrer   string // 이 페이지로 오기 전 페이지의 URL
+	Transition Transition
+	DwellMs    int64
+}
+
+// Skipped는 가져오지 않은 행과 그 이유입니다.
+type Skipped struct {
+	Index  int
+	Url    string
+	Reason string
+}
+
+// Session은 하나의 숲으로 만들 방문 기록 묶음입니다.
+type Session struct {
+	Forest *models.Forest  // Root에 하위 트리 전체가 들어 있으며 트리 ID는 정해져 있음
+	Visits []*models.Visit // 각 행의 방문 기록
+	Trees  []*models.Tree  // 새로 만들 트리 (Forest.Root 포함)
+}
+
+type Options struct {
+	UserID string
+	Gap    time.Duration // 0 이하면 DefaultSessionGap
+	URLs   *urlcanon.Canonicalizer
+}
+
+// Group은 방문 기록을 시간 순으로 정렬해 Gap보다 긴 공백마다 세션으로 나누고,
+// 각 세션에서 referrer를 따라 트리를 만듭니다.
+// 세션 안에서 같은 페이지(정규화한 URL)는 트리 하나로 합치고 방문 기록만 더합니다.
+// 시작 트리가 여러 개인 세션은 세션 이름의 루트 트리를 만들어 그 아래에 둡니다.
+func Group(records []Record, opts Options) ([]*Session, []Skipped) {
+	gap := opts.Gap
+	if gap <= 0 {
+		gap = DefaultSessionGap
+	}
+	var skipped []Skipped
+	var valid []Record
+	for _, r := range records {
+		r.Url = strings.TrimSpace(r.Url)
+		if reason := invalidReason(r, opts.URLs); reason != "" {
+			skipped = append(skipped, Skipped{Index: r.Index, Url: r.Url, Reason: reason})
+			continue
+		}
+		valid = append(valid, r)
+	}
+	slices.SortStableFunc(valid, func(a, b Record) int {
+		return a.VisitedAt.Compare(b.VisitedAt)
+	})
+
+	var sessions []*Session
+	var current *sessionBuilder
+	for _, r := range valid {
+		if current == nil || r.VisitedAt.Sub(current.last) > gap {
+			if current != nil {
+				sessions = append(sessions, current.build())
+			}
+			current = newSessionBuilder(opts.UserID, r.VisitedAt, opts.URLs)
+		}
+		current.add(r)
+	}
+	if current != nil {
+		sessions = append(sessions, current.build())
+	}
+	return sessions, skipped
+}
+
+func invalidReason(r Record, urls *urlcanon.Canonicalizer) string {
+	switch {
+	case r.Transition == TransitionSubframe:
+		return ReasonSubframe
+	case !isWebURL(urls.Canonicalize(r.Url)):
+		return ReasonInvalidURL
+	case r.VisitedAt.IsZero():
+		return ReasonInvalidTime
+	case r.DwellMs < 0:
+		return ReasonInvalidDwell
+	}
+	return ""
+}
+
+func isWebURL(canonical string) bool {
+	return strings.HasPrefix(canonical, "http://") || strings.HasPrefix(canonical, "https://")
+}
+
+// follows는 referrer 페이지의 자식으로 붙일 이동 방식인지 확인합니다.
+// 직접 입력하거나 북마크로 연 페이지는 referrer가 있어도 새 시작 트리가 됩니다.
+func follows(transition Transition) bool {
+	switch transition {
+	case TransitionTyped, TransitionBookmark, TransitionGenerated:
+		return false
+	}
+	return true
+}
+
+type sessionBuilder struct {
+	userID string
+	urls   *urlcanon.Canonicalizer
+	start  time.Time
+	last   time.Time
+	roots  []*models.Tree
+	trees  []*models.Tree
+	pages  map[string]*models.Tree // 정규화한 URL → 트리
+	visits []*models.Visit
+}
+
+func newSessionBuilder(userID string, start time.Time, urls *urlcanon.Canonicalizer) *sessionBuilder {
+	return &sessionBuilder{
+		userID: userID,
+		urls:   urls,
+		start:  start,
+		last:   start,
+		pages:  map[string]*models.Tree{},
+	}
+}
+
+func (b *sessionBuilder) add(r Record) {
+	b.last = r.VisitedAt
+	canonical := b.urls.Canonicalize(r.Url)
+	tree, ok := b.pages[canonical]
+	if !ok {
+		tree = &models.Tree{
+			Id:           uuid.New().String(),
+			Name:         cmp.Or(strings.TrimSpace(r.Title), canonical),
+			Url:          r.Url,
+			CanonicalUrl: canonical,
+		}
+		var parent *models.Tree
+		if r.Referrer != "" && follows(r.Transition) {
+			parent = b.pages[b.urls.Canonicalize(strings.TrimSpace(r.Referrer))]
+		}
+		if parent != nil {
+			parent.Children = append(parent.Children, tree)
+		} else {
+			b.roots = append(b.roots, tree)
+		}
+		b.pages[canonical] = tree
+		b.trees = append(b.trees, tree)
+	}
+	b.visits = append(b.visits, &models.Visit{
+		TreeId:    tree.Id,
+		UserId:    b.userID,
+		VisitedAt: r.VisitedAt,
+		DwellMs:   r.DwellMs,
+	})
+}
+
+func (b *sessionBuilder) build() *Session {
+	name := "History " + b.start.Format("2006-01-02 15:04")
+	root := b.roots[0]
+	trees := b.trees
+	if len(b.roots) > 1 {
+		root = &models.Tree{Id: uuid.New().String(), Name: name, Children: b.roots}
+		trees = append([]*models.Tree{root}, trees...)
+	}
+	return &Session{
+		Forest: &models.Forest{
+			UserId:      b.userID,
+			Name:        name,
+			Description: fmt.Sprintf("Imported browser history from %s to %s", b.start.Format(time.RFC3339), b.last.Format(time.RFC3339)),
+			Root:        root,
+		},
+		Visits: b.visits,
+		Trees:  trees,
+	}
+}
@@ -0,0 +1,55 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jdk829355/InForest_back/internal/store"
+	"go.uber.org/zap"
+)
+
+type purger struct {
+	store     *store.Store
+	retention time.Duration
+	interval  time.Duration
+	logger    *zap.Logger
+}
+
+// NewPurger는 retention보다 오래 휴지통에 있던 항목을 interval마다 영구 삭제하는 purger를 생성합니다.
+func NewPurger(store *store.Store, retention time.Duration, interval time.Duration, logger *zap.Logger) (*purger, error) {
+	if retention <= 0 || interval <= 0 {
+		return nil, fmt.Errorf("invalid trash purge settings: retention=%s, interval=%s", retention, interval)
+	}
+	return &purger{
+		store:     store,
+		retention: retention,
+		interval:  interval,
+		logger:    logger,
+	}, nil
+}
+
+// Run은 ctx가 취소될 때까지 주기적으로 휴지통을 비웁니다.
+func (p *purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.purge(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *purger) purge(ctx context.Context) {
+	purged, err := p.store.PurgeTrash(ctx, "", time.Now().Add(-p.retention))
+	if err != nil {
+		p.logger.Error("Failed to purge trash", zap.Int("purged", purged), zap.Error(err))
+		return
+	}
+	if purged > 0 {
+		p.logger.Info("Purged expired trash", zap.Int("purged", purged))
+	}
+}
@@ -0,0 +1,99 @@
+package urlcanon
+
+import (
+	"net/url"
+	"strings"
+)
+
+// DefaultTrackingParams는 기본으로 제거하는 추적용 쿼리 파라미터입니다. *로 끝나면 접두사로 비교합니다.
+var DefaultTrackingParams = []string{
+	"utm_*", "fbclid", "gclid", "dclid", "msclkid", "mc_cid", "mc_eid", "igshid", "ref_src", "_ga",
+}
+
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
+
+// Canonicalizer는 같은 페이지를 가리키는 URL을 하나의 정규 URL로 바꿉니다.
+type Canonicalizer struct {
+	params   map[string]bool // 정확히 일치하면 제거할 파라미터
+	prefixes []string        // 접두사가 일치하면 제거할 파라미터
+}
+
+// New는 trackingParams에 있는 쿼리 파라미터를 제거하는 Canonicalizer를 생성합니다.
+// 파라미터 이름은 대소문자를 구분하지 않으며, *로 끝나면 접두사로 비교합니다.
+func New(trackingParams []string) *Canonicalizer {
+	c := &Canonicalizer{params: map[string]bool{}}
+	for _, param := range trackingParams {
+		param = strings.ToLower(strings.TrimSpace(param))
+		if prefix, ok := strings.CutSuffix(param, "*"); ok {
+			c.prefixes = append(c.prefixes, prefix)
+		} else if param != "" {
+			c.params[param] = true
+		}
+	}
+	return c
+}
+
+// ParseTrackingParams는 쉼표로 구분된 파라미터 목록을 나눕니다.
+func ParseTrackingParams(list string) []string {
+	var params []string
+	for _, param := range strings.Split(list, ",") {
+		if param = strings.TrimSpace(param); param != "" {
+			params = append(params, param)
+		}
+	}
+	return params
+}
+
+// Canonicalize는 scheme과 host를 소문자로 바꾸고 기본 포트, fragment, 추적용 파라미터를 제거합니다.
+// 남은 쿼리 파라미터는 이름 순으로 정렬하고, 경로 끝의 /는 루트 경로(/)를 제외하고 제거합니다.
+// scheme이나 host가 없어 해석할 수 없는 URL은 앞뒤 공백만 제거해 반환합니다.
+func (c *Canonicalizer) Canonicalize(raw string) string {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return raw
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if port := u.Port(); port != "" && defaultPorts[u.Scheme] == port {
+		u.Host = strings.TrimSuffix(u.Host, ":"+port)
+	}
+	u.Fragment = ""
+	u.RawFragment = ""
+
+	query := u.Query()
+	for param := range query {
+		if c.isTracking(param) {
+			query.Del(param)
+		}
+	}
+	u.RawQuery = query.Encode() // 이름 순으로 정렬됨
+	u.ForceQuery = false
+
+	// 인코딩된 경로에서 /를 제거해야 %2F 같은 이스케이프가 풀려 다른 URL과 합쳐지지 않음
+	path := strings.TrimRight(u.EscapedPath(), "/")
+	if path == "" {
+		path = "/"
+	}
+	if unescaped, err := url.PathUnescape(path); err == nil {
+		u.Path = unescaped
+		u.RawPath = path
+	}
+	return u.String()
+}
+
+func (c *Canonicalizer) isTracking(param string) bool {
+	param = strings.ToLower(param)
+	if c.params[param] {
+		return true
+	}
+	for _, prefix := range c.prefixes {
+		if strings.HasPrefix(param, prefix) {
+			return true
+		}
+	}
+	return false
+}
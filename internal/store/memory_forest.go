@@ -0,0 +1,1262 @@
+package store
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jdk829355/InForest_back/models"
+)
+
+// MemoryForestStore는 DB 없이 동작하는 ForestRepository 구현체입니다.
+// 핸들러 테스트와 로컬 데모 용도로 사용하며, depth/total_trees 계산 방식은 Neo4jStore와 동일합니다.
+type MemoryForestStore struct {
+	mu      sync.RWMutex
+	forests map[string]*memoryForest
+	trees   map[string]*memoryTree
+	links   map[string]*memoryShareLink // 링크 ID → 링크
+}
+
+type memoryShareLink struct {
+	link      models.ShareLink // Token은 저장하지 않음
+	tokenHash string
+}
+
+type memoryForest struct {
+	forest    models.Forest // Root는 사용하지 않음
+	rootID    string
+	members   map[string]models.ForestRole // 공유받은 사용자 ID → 역할
+	deletedAt time.Time                    // 휴지통에 있으면 삭제 시각
+}
+
+type memoryTree struct {
+	tree      models.Tree // Children은 사용하지 않음
+	forestID  string
+	parentID  string   // 숲에 바로 연결된 트리는 ""
+	children  []string // 자식 트리 ID (생성 순서, 휴지통에 있는 트리 포함)
+	visits    []models.Visit
+	deletedAt time.Time // 휴지통에 있으면 삭제 시각
+	promoted  []string  // cascade 없이 삭제될 때 부모로 옮겨진 자식 트리 ID (원래 순서, 복원할 때 다시 데려옴)
+}
+
+func NewMemoryForestStore() *MemoryForestStore {
+	return &MemoryForestStore{
+		forests: map[string]*memoryForest{},
+		trees:   map[string]*memoryTree{},
+		links:   map[string]*memoryShareLink{},
+	}
+}
+
+func (s *MemoryForestStore) GetForestByUser(ctx context.Context, userID string, opts ForestListOptions) ([]*models.Forest, string, error) {
+	opts, err := opts.normalize()
+	if err != nil {
+		return nil, "", err
+	}
+	cursor, err := decodeForestCursor(opts.PageToken, opts)
+	if err != nil {
+		return nil, "", err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// Neo4jStore와 같은 순서가 되도록 정렬 키, ID 순으로 정렬
+	compare := func(a, b *models.Forest) int {
+		c := compareForestKeys(forestSortValue(a, opts.SortBy), a.Id, forestSortValue(b, opts.SortBy), b.Id)
+		if opts.Descending {
+			return -c
+		}
+		return c
+	}
+	var candidates []*models.Forest
+	for _, f := range s.forests {
+		_, shared := f.members[userID]
+		if (f.forest.UserId != userID && !(opts.IncludeShared && shared)) || !f.deletedAt.IsZero() {
+			continue
+		}
+		if !strings.HasPrefix(f.forest.Name, opts.NamePrefix) || f.forest.TotalTrees < opts.MinTrees {
+			continue
+		}
+		if cursor != nil {
+			c := compareForestKeys(forestSortValue(&f.forest, opts.SortBy), f.forest.Id, cursor.sortValue(), cursor.ID)
+			if opts.Descending {
+				c = -c
+			}
+			if c <= 0 {
+				continue
+			}
+		}
+		candidates = append(candidates, &f.forest)
+	}
+	slices.SortFunc(candidates, compare)
+
+	var forests []*models.Forest
+	nextPageToken := ""
+	for i, candidate := range candidates {
+		if i == opts.PageSize {
+			last := forests[len(forests)-1]
+			nextPageToken = encodeForestCursor(opts, forestSortKey(forestSortValue(last, opts.SortBy)), last.Id)
+			break
+		}
+		forests = append(forests, s.buildForest(s.forests[candidate.Id], opts.IncludeChildren))
+	}
+	return forests, nextPageToken, nil
+}
+
+func (s *MemoryForestStore) CreateForest(ctx context.Context, forest *models.Forest, root *models.Tree) error {
+	if forest == nil || root == nil {
+		return errors.New("invalid forest data")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	forest.Id = uuid.New().String()
+	forest.Depth = 1
+	forest.TotalTrees = 1
+	forest.CreatedAt = time.Now().UTC()
+	forest.UpdatedAt = forest.CreatedAt
+	root.Id = uuid.New().String()
+	root.Children = nil
+	root.Summary = ""
+	root.CreatedAt = forest.CreatedAt
+	root.UpdatedAt = forest.CreatedAt
+
+	stored := *forest
+	stored.Root = nil
+	s.forests[forest.Id] = &memoryForest{forest: stored, rootID: root.Id}
+	s.trees[root.Id] = &memoryTree{tree: *root, forestID: forest.Id}
+	return nil
+}
+
+func (s *MemoryForestStore) CreateTree(ctx context.Context, tree *models.Tree, parentID string, index int) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parent, ok := s.liveTree(parentID)
+	if !ok {
+		return "", ErrTreeNotFound
+	}
+
+	tree.Id = uuid.New().String()
+	tree.Children = nil
+	tree.Summary = ""
+	tree.CreatedAt = time.Now().UTC()
+	tree.UpdatedAt = tree.CreatedAt
+
+	s.trees[tree.Id] = &memoryTree{tree: *tree, forestID: parent.forestID, parentID: parentID}
+	parent.children = insertChild(parent.children, s.liveChildrenOf(parent), tree.Id, index)
+
+	// 부모 트리의 숲 정보 업데이트
+	f := s.forests[parent.forestID]
+	f.forest.TotalTrees++
+	f.forest.UpdatedAt = time.Now().UTC()
+	if depth := s.depthOf(parentID) + 1; depth > f.forest.Depth {
+		f.forest.Depth = depth
+	}
+	return tree.Id, nil
+}
+
+func (s *MemoryForestStore) GetForest(ctx context.Context, forestID string, includeChildren bool, maxDepth int) (*models.Forest, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	f, ok := s.liveForest(forestID)
+	if !ok {
+		return nil, ErrForestNotFound
+	}
+	forest := s.buildForest(f, false)
+	if forest.Root != nil {
+		forest.Root = s.buildSubtree(f.rootID, subtreeDepth(includeChildren, maxDepth))
+	}
+	return forest, nil
+}
+
+func (s *MemoryForestStore) UpdateForest(ctx context.Context, forest *models.Forest, fields []string) (models.Forest, error) {
+	if err := checkUpdate(forest.Id, fields, forestUpdateFields); err != nil {
+		return models.Forest{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.liveForest(forest.Id)
+	if !ok {
+		return models.Forest{}, ErrForestNotFound
+	}
+	for _, field := range fields {
+		switch field {
+		case "name":
+			f.forest.Name = forest.Name
+		case "description":
+			f.forest.Description = forest.Description
+		}
+	}
+	f.forest.UpdatedAt = time.Now().UTC()
+	return *s.buildForest(f, false), nil
+}
+
+// DeleteForest는 숲을 휴지통으로 옮기고, 더 이상 보이지 않게 된 트리 ID를 반환합니다.
+func (s *MemoryForestStore) DeleteForest(ctx context.Context, forestID string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.liveForest(forestID)
+	if !ok {
+		return nil, ErrForestNotFound
+	}
+	idsToDelete := []string{}
+	if s.isLive(f.rootID) {
+		idsToDelete = append(idsToDelete, f.rootID)
+		idsToDelete = append(idsToDelete, s.liveDescendantsOf(f.rootID)...)
+	}
+	f.deletedAt = time.Now().UTC()
+	return idsToDelete, nil
+}
+
+func (s *MemoryForestStore) UpdateTree(ctx context.Context, tree *models.Tree, fields []string) (models.Tree, error) {
+	if err := checkUpdate(tree.Id, fields, treeUpdateFields); err != nil {
+		return models.Tree{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.liveTree(tree.Id)
+	if !ok {
+		return models.Tree{}, ErrTreeNotFound
+	}
+	for _, field := range fields {
+		switch field {
+		case "name":
+			t.tree.Name = tree.Name
+		case "url":
+			t.tree.Url = tree.Url
+			t.tree.CanonicalUrl = tree.CanonicalUrl
+		}
+	}
+	t.tree.UpdatedAt = time.Now().UTC()
+	return *s.buildTree(tree.Id, false), nil
+}
+
+func (s *MemoryForestStore) GetTreeByID(ctx context.Context, treeID string, includeChildren bool, maxDepth int) (*models.Tree, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.liveTree(treeID); !ok {
+		return nil, ErrTreeNotFound
+	}
+	return s.buildSubtree(treeID, subtreeDepth(includeChildren, maxDepth)), nil
+}
+
+func (s *MemoryForestStore) RecordVisit(ctx context.Context, visit *models.Visit) (*models.Tree, error) {
+	if visit.DwellMs < 0 {
+		return nil, ErrInvalidVisit
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.liveTree(visit.TreeId)
+	if !ok {
+		return nil, ErrTreeNotFound
+	}
+	addVisit(t, visit)
+	return s.buildTree(visit.TreeId, false), nil
+}
+
+// addVisit은 방문 기록을 저장하고 트리의 방문 집계를 갱신합니다.
+func addVisit(t *memoryTree, visit *models.Visit) {
+	visit.Id = uuid.New().String()
+	t.visits = append(t.visits, *visit)
+	if t.tree.FirstVisitedAt.IsZero() || visit.VisitedAt.Before(t.tree.FirstVisitedAt) {
+		t.tree.FirstVisitedAt = visit.VisitedAt
+	}
+	if visit.VisitedAt.After(t.tree.LastVisitedAt) {
+		t.tree.LastVisitedAt = visit.VisitedAt
+	}
+	t.tree.VisitCount++
+	t.tree.TotalDwellMs += visit.DwellMs
+}
+
+func (s *MemoryForestStore) FindDuplicateTree(ctx context.Context, forestID string, canonicalURL string) (*models.Tree, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var duplicates []*models.ForestTree
+	for id, t := range s.trees {
+		if t.forestID != forestID || !s.isLive(id) {
+			continue
+		}
+		if cmp.Or(t.tree.CanonicalUrl, t.tree.Url) == canonicalURL {
+			duplicates = append(duplicates, &models.ForestTree{Tree: *s.buildTree(id, false), ForestId: forestID})
+		}
+	}
+	if len(duplicates) == 0 {
+		return nil, ErrTreeNotFound
+	}
+	sortForestTrees(duplicates)
+	return &duplicates[0].Tree, nil
+}
+
+func (s *MemoryForestStore) GetForestOwner(ctx context.Context, forestID string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	f, ok := s.forests[forestID]
+	if !ok {
+		return "", ErrForestNotFound
+	}
+	return f.forest.UserId, nil
+}
+
+func (s *MemoryForestStore) GetTreeOwner(ctx context.Context, treeID string) (string, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.trees[treeID]
+	if !ok {
+		return "", "", ErrTreeNotFound
+	}
+	return t.forestID, s.forests[t.forestID].forest.UserId, nil
+}
+
+func (s *MemoryForestStore) GetMemberRole(ctx context.Context, forestID string, userID string) (models.ForestRole, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	f, ok := s.forests[forestID]
+	if !ok {
+		return "", ErrForestNotFound
+	}
+	return f.members[userID], nil
+}
+
+func (s *MemoryForestStore) ShareForest(ctx context.Context, forestID string, userID string, role models.ForestRole) (*models.ForestMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.liveForest(forestID)
+	if !ok {
+		return nil, ErrForestNotFound
+	}
+	if err := checkShare(f.forest.UserId, userID, role); err != nil {
+		return nil, err
+	}
+	if f.members == nil {
+		f.members = map[string]models.ForestRole{}
+	}
+	f.members[userID] = role
+	return &models.ForestMember{UserId: userID, Role: role}, nil
+}
+
+func (s *MemoryForestStore) UnshareForest(ctx context.Context, forestID string, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.liveForest(forestID)
+	if !ok {
+		return ErrForestNotFound
+	}
+	if _, ok := f.members[userID]; !ok {
+		return ErrMemberNotFound
+	}
+	delete(f.members, userID)
+	return nil
+}
+
+func (s *MemoryForestStore) ListForestMembers(ctx context.Context, forestID string) ([]*models.ForestMember, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	f, ok := s.liveForest(forestID)
+	if !ok {
+		return nil, ErrForestNotFound
+	}
+	members := []*models.ForestMember{}
+	for userID, role := range f.members {
+		members = append(members, &models.ForestMember{UserId: userID, Role: role})
+	}
+	sortMembers(members)
+	return members, nil
+}
+
+func (s *MemoryForestStore) CreateShareLink(ctx context.Context, forestID string, expiresAt time.Time) (*models.ShareLink, error) {
+	if err := checkShareLinkExpiry(expiresAt); err != nil {
+		return nil, err
+	}
+	token, hash, err := newShareToken()
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.liveForest(forestID); !ok {
+		return nil, ErrForestNotFound
+	}
+	link := models.ShareLink{
+		Id:        uuid.New().String(),
+		ForestId:  forestID,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now().UTC(),
+	}
+	s.links[link.Id] = &memoryShareLink{link: link, tokenHash: hash}
+	link.Token = token
+	return &link, nil
+}
+
+func (s *MemoryForestStore) GetShareLink(ctx context.Context, token string) (*models.ShareLink, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	hash := hashShareToken(token)
+	for _, l := range s.links {
+		if l.tokenHash != hash {
+			continue
+		}
+		if _, ok := s.liveForest(l.link.ForestId); !ok || !l.link.ExpiresAt.After(time.Now().UTC()) {
+			break
+		}
+		link := l.link
+		return &link, nil
+	}
+	return nil, ErrShareLinkNotFound
+}
+
+func (s *MemoryForestStore) ListShareLinks(ctx context.Context, forestID string) ([]*models.ShareLink, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := time.Now().UTC()
+	links := []*models.ShareLink{}
+	for _, l := range s.links {
+		if l.link.ForestId == forestID && l.link.ExpiresAt.After(now) {
+			link := l.link
+			links = append(links, &link)
+		}
+	}
+	sortShareLinks(links)
+	return links, nil
+}
+
+func (s *MemoryForestStore) RevokeShareLink(ctx context.Context, forestID string, linkID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, ok := s.links[linkID]
+	if !ok || l.link.ForestId != forestID {
+		return ErrShareLinkNotFound
+	}
+	delete(s.links, linkID)
+	return nil
+}
+
+func (s *MemoryForestStore) AddForestTags(ctx context.Context, forestID string, tags []string) ([]string, error) {
+	return s.updateForestTags(forestID, tags, addTags)
+}
+
+func (s *MemoryForestStore) RemoveForestTags(ctx context.Context, forestID string, tags []string) ([]string, error) {
+	return s.updateForestTags(forestID, tags, removeTags)
+}
+
+func (s *MemoryForestStore) AddTreeTags(ctx context.Context, treeID string, tags []string) ([]string, error) {
+	return s.updateTreeTags(treeID, tags, addTags)
+}
+
+func (s *MemoryForestStore) RemoveTreeTags(ctx context.Context, treeID string, tags []string) ([]string, error) {
+	return s.updateTreeTags(treeID, tags, removeTags)
+}
+
+// 저장된 태그 슬라이스는 조회 결과와 공유되므로 항상 새 슬라이스로 교체함
+func (s *MemoryForestStore) updateForestTags(forestID string, tags []string, update func(current, tags []string) []string) ([]string, error) {
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.liveForest(forestID)
+	if !ok {
+		return nil, ErrForestNotFound
+	}
+	f.forest.Tags = update(f.forest.Tags, tags)
+	f.forest.UpdatedAt = time.Now().UTC()
+	return f.forest.Tags, nil
+}
+
+func (s *MemoryForestStore) updateTreeTags(treeID string, tags []string, update func(current, tags []string) []string) ([]string, error) {
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.liveTree(treeID)
+	if !ok {
+		return nil, ErrTreeNotFound
+	}
+	t.tree.Tags = update(t.tree.Tags, tags)
+	t.tree.UpdatedAt = time.Now().UTC()
+	return t.tree.Tags, nil
+}
+
+func (s *MemoryForestStore) ListTags(ctx context.Context, userID string) ([]*models.TagCount, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counts := map[string]*models.TagCount{}
+	count := func(tag string) *models.TagCount {
+		if counts[tag] == nil {
+			counts[tag] = &models.TagCount{Name: tag}
+		}
+		return counts[tag]
+	}
+	for _, f := range s.forests {
+		if f.forest.UserId != userID || !f.deletedAt.IsZero() {
+			continue
+		}
+		for _, tag := range f.forest.Tags {
+			count(tag).ForestCount++
+		}
+	}
+	for id, t := range s.trees {
+		if s.forests[t.forestID] == nil || s.forests[t.forestID].forest.UserId != userID || !s.isLive(id) {
+			continue
+		}
+		for _, tag := range t.tree.Tags {
+			count(tag).TreeCount++
+		}
+	}
+	tags := make([]*models.TagCount, 0, len(counts))
+	for _, c := range counts {
+		tags = append(tags, c)
+	}
+	slices.SortFunc(tags, func(a, b *models.TagCount) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return tags, nil
+}
+
+func (s *MemoryForestStore) FindTreesByTag(ctx context.Context, userID string, tag string) ([]*models.ForestTree, error) {
+	tag, err := normalizeTag(tag)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	trees := []*models.ForestTree{}
+	for id, t := range s.trees {
+		if s.forests[t.forestID] == nil || s.forests[t.forestID].forest.UserId != userID || !s.isLive(id) {
+			continue
+		}
+		if slices.Contains(t.tree.Tags, tag) {
+			trees = append(trees, &models.ForestTree{Tree: *s.buildTree(id, false), ForestId: t.forestID})
+		}
+	}
+	sortForestTrees(trees)
+	return trees, nil
+}
+
+func (s *MemoryForestStore) FindTreesByUrl(ctx context.Context, userID string, canonicalURL string) ([]*models.UrlMatch, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matches := []*models.UrlMatch{}
+	for id, t := range s.trees {
+		if !s.inSearchScope(id, userID, SearchOptions{Scope: SearchScopeAll}) || cmp.Or(t.tree.CanonicalUrl, t.tree.Url) != canonicalURL {
+			continue
+		}
+		var path []models.TreeRef
+		for pathID := id; pathID != ""; pathID = s.trees[pathID].parentID {
+			path = append(path, models.TreeRef{Id: pathID, Name: s.trees[pathID].tree.Name})
+		}
+		slices.Reverse(path)
+		f := s.forests[t.forestID]
+		matches = append(matches, &models.UrlMatch{Tree: *s.buildTree(id, false), ForestId: f.forest.Id, ForestName: f.forest.Name, Path: path})
+	}
+	sortUrlMatches(matches)
+	return matches, nil
+}
+
+func (s *MemoryForestStore) BackfillCanonicalUrls(ctx context.Context, canonicalize func(string) string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	filled := 0
+	for _, t := range s.trees {
+		if t.tree.CanonicalUrl == "" && t.tree.Url != "" {
+			t.tree.CanonicalUrl = canonicalize(t.tree.Url)
+			filled++
+		}
+	}
+	return filled, nil
+}
+
+// SearchTrees는 이름, URL, 요약을 합친 문자열에 모든 단어가 부분 문자열로 들어 있는 트리를 찾습니다.
+func (s *MemoryForestStore) SearchTrees(ctx context.Context, userID string, terms []string, opts SearchOptions, limit int) ([]*models.ForestTree, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	trees := []*models.ForestTree{}
+	for id, t := range s.trees {
+		if !s.inSearchScope(id, userID, opts) {
+			continue
+		}
+		if containsTerms(t.tree.Name+"\n"+t.tree.Url+"\n"+t.tree.Summary, terms) == len(terms) {
+			trees = append(trees, &models.ForestTree{Tree: *s.buildTree(id, false), ForestId: t.forestID})
+		}
+	}
+	sortForestTrees(trees)
+	if len(trees) > limit {
+		trees = trees[:limit]
+	}
+	return trees, nil
+}
+
+func (s *MemoryForestStore) TreesInScope(ctx context.Context, userID string, treeIDs []string, opts SearchOptions) ([]*models.ForestTree, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var trees []*models.ForestTree
+	for _, id := range treeIDs {
+		if s.inSearchScope(id, userID, opts) {
+			trees = append(trees, &models.ForestTree{Tree: *s.buildTree(id, false), ForestId: s.trees[id].forestID})
+		}
+	}
+	return trees, nil
+}
+
+func (s *MemoryForestStore) WalkForest(ctx context.Context, forestID string, visit func(node *models.TreeNode) error) error {
+	// visit이 느려도 잠금을 오래 잡지 않도록 방문 순서를 먼저 만들어 둠
+	s.mu.RLock()
+	f, ok := s.liveForest(forestID)
+	if !ok {
+		s.mu.RUnlock()
+		return ErrForestNotFound
+	}
+	var nodes []*models.TreeNode
+	if s.isLive(f.rootID) {
+		nodes = append(nodes, &models.TreeNode{Tree: *s.buildTree(f.rootID, false)})
+		for i := 0; i < len(nodes); i++ {
+			parent := nodes[i]
+			for _, childID := range s.liveChildrenOf(s.trees[parent.Tree.Id]) {
+				nodes = append(nodes, &models.TreeNode{
+					Tree:     *s.buildTree(childID, false),
+					ParentId: parent.Tree.Id,
+					Depth:    parent.Depth + 1,
+				})
+			}
+		}
+	}
+	s.mu.RUnlock()
+
+	for _, node := range nodes {
+		if err := visit(node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *MemoryForestStore) ListChildren(ctx context.Context, parentID string, pageSize int, pageToken string) ([]*models.Tree, string, error) {
+	offset, err := decodeOffsetToken(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	pageSize = childrenPageSize(pageSize)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	parent, ok := s.liveTree(parentID)
+	if !ok {
+		return nil, "", ErrTreeNotFound
+	}
+	liveChildren := s.liveChildrenOf(parent)
+	if offset > len(liveChildren) {
+		offset = len(liveChildren)
+	}
+	end := min(offset+pageSize, len(liveChildren))
+
+	children := make([]*models.Tree, 0, end-offset)
+	for _, childID := range liveChildren[offset:end] {
+		child := s.buildTree(childID, false)
+		child.ChildCount = int32(len(s.liveChildrenOf(s.trees[childID])))
+		children = append(children, child)
+	}
+	nextPageToken := ""
+	if end < len(liveChildren) {
+		nextPageToken = encodeOffsetToken(end)
+	}
+	return children, nextPageToken, nil
+}
+
+// DeleteTree는 트리를 휴지통으로 옮기고, 더 이상 보이지 않게 된 트리 ID를 반환합니다.
+// cascade가 false면 자식들을 삭제되는 트리의 부모에 연결한 뒤 트리만 휴지통으로 옮깁니다.
+func (s *MemoryForestStore) DeleteTree(ctx context.Context, treeID string, cascade bool) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	target, ok := s.liveTree(treeID)
+	if !ok {
+		return nil, ErrTreeNotFound
+	}
+	deletedId := []string{treeID}
+
+	if cascade {
+		deletedId = append(deletedId, s.liveDescendantsOf(treeID)...)
+	} else {
+		liveChildren := s.liveChildrenOf(target)
+		// 루트 트리는 숲에 하나만 연결될 수 있으므로 자식이 여럿이면 승격할 수 없음
+		if target.parentID == "" && len(liveChildren) > 1 {
+			return nil, ErrRootHasMultipleChildren
+		}
+		// 자식들을 삭제되는 트리의 부모에 같은 위치로 연결 (삭제되는 트리는 빈 리프로 남음)
+		for _, childID := range target.children {
+			s.trees[childID].parentID = target.parentID
+		}
+		if target.parentID == "" {
+			if len(liveChildren) == 1 {
+				s.forests[target.forestID].rootID = liveChildren[0]
+			}
+		} else {
+			s.replaceChild(target, append(append([]string{}, target.children...), treeID))
+		}
+		target.promoted = target.children
+		target.children = nil
+	}
+	target.deletedAt = time.Now().UTC()
+
+	// 숲 정보 업데이트
+	s.recount(target.forestID)
+	return deletedId, nil
+}
+
+func (s *MemoryForestStore) MoveTree(ctx context.Context, treeID string, newParentID string) (*models.Tree, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	target, ok := s.liveTree(treeID)
+	if !ok {
+		return nil, ErrTreeNotFound
+	}
+	newParent, ok := s.liveTree(newParentID)
+	if !ok {
+		return nil, ErrTreeNotFound
+	}
+	if target.parentID == "" {
+		return nil, ErrMoveRootTree
+	}
+	for id := newParentID; id != ""; id = s.trees[id].parentID {
+		if id == treeID {
+			return nil, ErrMoveIntoDescendant
+		}
+	}
+
+	srcForestID := target.forestID
+	s.replaceChild(target, nil)
+	target.parentID = newParentID
+	newParent.children = append(newParent.children, treeID)
+	for _, id := range append([]string{treeID}, s.descendantsOf(treeID)...) {
+		s.trees[id].forestID = newParent.forestID
+	}
+
+	s.recount(srcForestID)
+	s.recount(newParent.forestID)
+	return s.buildTree(treeID, false), nil
+}
+
+func (s *MemoryForestStore) ReorderChildren(ctx context.Context, parentID string, childIDs []string) (*models.Tree, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parent, ok := s.liveTree(parentID)
+	if !ok {
+		return nil, ErrTreeNotFound
+	}
+	children, err := reorderChildren(parent.children, s.liveChildrenOf(parent), childIDs)
+	if err != nil {
+		return nil, err
+	}
+	parent.children = children
+	return s.buildTree(parentID, true), nil
+}
+
+func (s *MemoryForestStore) SplitForest(ctx context.Context, treeID string, forest *models.Forest) (*models.Forest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	target, ok := s.liveTree(treeID)
+	if !ok {
+		return nil, ErrTreeNotFound
+	}
+	if target.parentID == "" {
+		return nil, ErrMoveRootTree
+	}
+	src := s.forests[target.forestID]
+
+	now := time.Now().UTC()
+	created := &memoryForest{
+		forest: models.Forest{
+			Id:          uuid.New().String(),
+			UserId:      src.forest.UserId,
+			Name:        forest.Name,
+			Description: forest.Description,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+		rootID: treeID,
+	}
+	s.forests[created.forest.Id] = created
+
+	s.replaceChild(target, nil)
+	target.parentID = ""
+	for _, id := range append([]string{treeID}, s.descendantsOf(treeID)...) {
+		s.trees[id].forestID = created.forest.Id
+	}
+
+	s.recount(src.forest.Id)
+	s.recount(created.forest.Id)
+	return s.buildForest(created, false), nil
+}
+
+func (s *MemoryForestStore) GraftForest(ctx context.Context, forestID string, targetTreeID string) (*models.Forest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	src, ok := s.liveForest(forestID)
+	if !ok || !s.isLive(src.rootID) {
+		return nil, ErrForestNotFound
+	}
+	target, ok := s.liveTree(targetTreeID)
+	if !ok {
+		return nil, ErrTreeNotFound
+	}
+	if target.forestID == forestID {
+		return nil, ErrMoveIntoDescendant
+	}
+	dst := s.forests[target.forestID]
+	if dst.forest.UserId != src.forest.UserId {
+		return nil, ErrForestOwnerMismatch
+	}
+
+	// 루트 트리와 휴지통에 있는 이전 루트 트리들을 모두 대상 트리 아래로 옮김
+	for _, rootID := range s.forestRootsOf(forestID) {
+		root := s.trees[rootID]
+		root.parentID = targetTreeID
+		target.children = append(target.children, rootID)
+		for _, id := range append([]string{rootID}, s.descendantsOf(rootID)...) {
+			s.trees[id].forestID = dst.forest.Id
+		}
+	}
+	s.removeForest(forestID)
+
+	s.recount(dst.forest.Id)
+	return s.buildForest(dst, false), nil
+}
+
+func (s *MemoryForestStore) CopyTree(ctx context.Context, treeID string, targetParentID string) (*models.Tree, map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.liveTree(treeID); !ok {
+		return nil, nil, ErrTreeNotFound
+	}
+	parent, ok := s.liveTree(targetParentID)
+	if !ok {
+		return nil, nil, ErrTreeNotFound
+	}
+
+	idMap := map[string]string{}
+	copied := cloneTree(s.buildTree(treeID, true), idMap, time.Now().UTC())
+	s.insertSubtree(copied, parent.forestID, targetParentID)
+	parent.children = append(parent.children, copied.Id)
+	s.recount(parent.forestID)
+	return copied, idMap, nil
+}
+
+func (s *MemoryForestStore) CloneForest(ctx context.Context, forestID string, name string) (*models.Forest, map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	src, ok := s.liveForest(forestID)
+	if !ok {
+		return nil, nil, ErrForestNotFound
+	}
+	if !s.isLive(src.rootID) {
+		return nil, nil, ErrTreeNotFound
+	}
+
+	idMap := map[string]string{}
+	now := time.Now().UTC()
+	root := cloneTree(s.buildTree(src.rootID, true), idMap, now)
+	cloned := &memoryForest{forest: src.forest, rootID: root.Id}
+	cloned.forest.Id = uuid.New().String()
+	cloned.forest.CreatedAt = now
+	cloned.forest.UpdatedAt = now
+	if name != "" {
+		cloned.forest.Name = name
+	}
+	s.forests[cloned.forest.Id] = cloned
+	s.insertSubtree(root, cloned.forest.Id, "")
+	s.recount(cloned.forest.Id)
+	return s.buildForest(cloned, true), idMap, nil
+}
+
+func (s *MemoryForestStore) ImportForest(ctx context.Context, forest *models.Forest, visits []*models.Visit) error {
+	if forest == nil || forest.Root == nil {
+		return errors.New("invalid forest data")
+	}
+	if err := checkImportVisits(forest.Root, visits); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	forest.Id = uuid.New().String()
+	forest.CreatedAt = time.Now().UTC()
+	forest.UpdatedAt = forest.CreatedAt
+	forest.TotalTrees, forest.Depth = stampTree(forest.Root, forest.CreatedAt)
+
+	stored := *forest
+	stored.Root = nil
+	s.forests[forest.Id] = &memoryForest{forest: stored, rootID: forest.Root.Id}
+	s.insertSubtree(forest.Root, forest.Id, "")
+	for _, visit := range visits {
+		addVisit(s.trees[visit.TreeId], visit)
+	}
+	return nil
+}
+
+// ListTrash는 휴지통에 있는 숲과 트리를 삭제 시각의 역순으로 반환합니다. userID가 ""면 모든 사용자의 항목을 반환합니다.
+// 휴지통에 있는 숲이나 트리 아래의 트리는 상위 항목과 함께 복원되므로 목록에 포함하지 않습니다.
+func (s *MemoryForestStore) ListTrash(ctx context.Context, userID string) ([]*models.TrashItem, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	items := []*models.TrashItem{}
+	for _, f := range s.forests {
+		if f.deletedAt.IsZero() || (userID != "" && f.forest.UserId != userID) {
+			continue
+		}
+		items = append(items, &models.TrashItem{
+			Type:      models.TrashItemForest,
+			Id:        f.forest.Id,
+			Name:      f.forest.Name,
+			ForestId:  f.forest.Id,
+			UserId:    f.forest.UserId,
+			DeletedAt: f.deletedAt,
+		})
+	}
+	for _, t := range s.trees {
+		f := s.forests[t.forestID]
+		if t.deletedAt.IsZero() || !f.deletedAt.IsZero() || (userID != "" && f.forest.UserId != userID) {
+			continue
+		}
+		if t.parentID != "" && !s.isLive(t.parentID) {
+			continue
+		}
+		items = append(items, &models.TrashItem{
+			Type:      models.TrashItemTree,
+			Id:        t.tree.Id,
+			Name:      t.tree.Name,
+			ForestId:  t.forestID,
+			UserId:    f.forest.UserId,
+			DeletedAt: t.deletedAt,
+		})
+	}
+	sortTrashItems(items)
+	return items, nil
+}
+
+func (s *MemoryForestStore) RestoreForest(ctx context.Context, forestID string) (*models.Forest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.forests[forestID]
+	if !ok {
+		return nil, ErrForestNotFound
+	}
+	if f.deletedAt.IsZero() {
+		return nil, ErrNotInTrash
+	}
+	f.deletedAt = time.Time{}
+	return s.buildForest(f, false), nil
+}
+
+func (s *MemoryForestStore) RestoreTree(ctx context.Context, treeID string) (*models.Tree, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.trees[treeID]
+	if !ok {
+		return nil, ErrTreeNotFound
+	}
+	if t.deletedAt.IsZero() {
+		return nil, ErrNotInTrash
+	}
+	// 삭제될 때 부모로 옮겨진 자식 중 아직 같은 부모 아래에 있는 자식만 다시 데려옴
+	var reattach []string
+	for _, childID := range t.promoted {
+		if child, ok := s.trees[childID]; ok && child.forestID == t.forestID && child.parentID == t.parentID {
+			reattach = append(reattach, childID)
+		}
+	}
+	if t.parentID != "" {
+		if !s.isLive(t.parentID) {
+			return nil, ErrRestoreBlocked
+		}
+		parent := s.trees[t.parentID]
+		parent.children = collapseSiblings(parent.children, treeID, reattach)
+	} else {
+		// 숲에 바로 연결된 트리는 숲에 살아있는 루트 트리가 없거나, 루트 트리가 다시 데려올 자식일 때만 복원 가능
+		f := s.forests[t.forestID]
+		if !f.deletedAt.IsZero() || (f.rootID != treeID && s.isLive(f.rootID) && !slices.Contains(reattach, f.rootID)) {
+			return nil, ErrRestoreBlocked
+		}
+		f.rootID = treeID
+	}
+	for _, childID := range reattach {
+		s.trees[childID].parentID = treeID
+	}
+	t.children = append(t.children, reattach...)
+	t.promoted = nil
+	t.deletedAt = time.Time{}
+	s.recount(t.forestID)
+	return s.buildTree(treeID, false), nil
+}
+
+// PurgeForest는 숲과 그 안의 모든 트리(휴지통 포함)를 영구 삭제하고 삭제된 트리 ID를 반환합니다.
+func (s *MemoryForestStore) PurgeForest(ctx context.Context, forestID string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.forests[forestID]; !ok {
+		return nil, ErrForestNotFound
+	}
+	purged := []string{}
+	for _, rootID := range s.forestRootsOf(forestID) {
+		purged = append(purged, rootID)
+		purged = append(purged, s.descendantsOf(rootID)...)
+	}
+	for _, id := range purged {
+		delete(s.trees, id)
+	}
+	s.removeForest(forestID)
+	return purged, nil
+}
+
+// PurgeTree는 트리와 모든 하위 트리(휴지통 포함)를 영구 삭제하고 삭제된 트리 ID를 반환합니다.
+func (s *MemoryForestStore) PurgeTree(ctx context.Context, treeID string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	target, ok := s.trees[treeID]
+	if !ok {
+		return nil, ErrTreeNotFound
+	}
+	purged := append([]string{treeID}, s.descendantsOf(treeID)...)
+	if target.parentID == "" {
+		if f := s.forests[target.forestID]; f.rootID == treeID {
+			f.rootID = ""
+		}
+	} else {
+		s.replaceChild(target, nil)
+	}
+	for _, id := range purged {
+		delete(s.trees, id)
+	}
+	s.recount(target.forestID)
+	return purged, nil
+}
+
+// 유틸함수
+
+// isLive는 트리와 모든 상위 트리, 숲이 휴지통에 있지 않은지 확인합니다.
+func (s *MemoryForestStore) isLive(treeID string) bool {
+	for id := treeID; ; {
+		t, ok := s.trees[id]
+		if !ok || !t.deletedAt.IsZero() {
+			return false
+		}
+		if t.parentID == "" {
+			f := s.forests[t.forestID]
+			return f.rootID == id && f.deletedAt.IsZero()
+		}
+		id = t.parentID
+	}
+}
+
+// inSearchScope는 트리가 휴지통에 없고 userID의 검색 범위에 있는 숲에 속하는지 확인합니다.
+func (s *MemoryForestStore) inSearchScope(treeID string, userID string, opts SearchOptions) bool {
+	t, ok := s.trees[treeID]
+	if !ok || s.forests[t.forestID] == nil || !s.isLive(treeID) {
+		return false
+	}
+	f := s.forests[t.forestID]
+	if opts.ForestID != "" && f.forest.Id != opts.ForestID {
+		return false
+	}
+	_, shared := f.members[userID]
+	return (opts.Scope != SearchScopeShared && f.forest.UserId == userID) || (opts.Scope != SearchScopeOwned && shared)
+}
+
+func (s *MemoryForestStore) liveTree(treeID string) (*memoryTree, bool) {
+	if !s.isLive(treeID) {
+		return nil, false
+	}
+	return s.trees[treeID], true
+}
+
+func (s *MemoryForestStore) liveForest(forestID string) (*memoryForest, bool) {
+	f, ok := s.forests[forestID]
+	if !ok || !f.deletedAt.IsZero() {
+		return nil, false
+	}
+	return f, true
+}
+
+func (s *MemoryForestStore) liveChildrenOf(t *memoryTree) []string {
+	var ids []string
+	for _, childID := range t.children {
+		if s.trees[childID].deletedAt.IsZero() {
+			ids = append(ids, childID)
+		}
+	}
+	return ids
+}
+
+// forestRootsOf는 숲에 바로 연결된 모든 트리 ID(휴지통에 있는 이전 루트 포함)를 반환합니다.
+func (s *MemoryForestStore) forestRootsOf(forestID string) []string {
+	var ids []string
+	for id, t := range s.trees {
+		if t.forestID == forestID && t.parentID == "" && id != s.forests[forestID].rootID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	if f := s.forests[forestID]; f.rootID != "" {
+		ids = append([]string{f.rootID}, ids...)
+	}
+	return ids
+}
+
+// insertSubtree는 models.Tree 구조를 그대로 저장합니다. root를 부모에 연결하는 것은 호출하는 쪽에서 처리합니다.
+func (s *MemoryForestStore) insertSubtree(root *models.Tree, forestID string, parentID string) {
+	stored := &memoryTree{tree: *root, forestID: forestID, parentID: parentID}
+	stored.tree.Children = nil
+	for _, child := range root.Children {
+		stored.children = append(stored.children, child.Id)
+		s.insertSubtree(child, forestID, root.Id)
+	}
+	s.trees[root.Id] = stored
+}
+
+// removeForest는 숲 노드만 삭제합니다. (트리는 그대로 둠)
+func (s *MemoryForestStore) removeForest(forestID string) {
+	delete(s.forests, forestID)
+	for id, l := range s.links {
+		if l.link.ForestId == forestID {
+			delete(s.links, id)
+		}
+	}
+}
+
+// recount는 숲의 트리 구조를 기준으로 depth/total_trees를 다시 계산합니다.
+func (s *MemoryForestStore) recount(forestID string) {
+	f := s.forests[forestID]
+	f.forest.TotalTrees = 0
+	f.forest.Depth = 0
+	f.forest.UpdatedAt = time.Now().UTC()
+	if s.trees[f.rootID] != nil && s.trees[f.rootID].deletedAt.IsZero() {
+		f.forest.TotalTrees = int32(1 + len(s.liveDescendantsOf(f.rootID)))
+		f.forest.Depth = s.maxDepthOf(f.rootID)
+	}
+}
+
+// replaceChild는 부모(또는 숲)에서 target 위치를 replacement로 교체합니다.
+func (s *MemoryForestStore) replaceChild(target *memoryTree, replacement []string) {
+	if target.parentID == "" {
+		f := s.forests[target.forestID]
+		f.rootID = ""
+		if len(replacement) > 0 {
+			f.rootID = replacement[0]
+		}
+		return
+	}
+	parent := s.trees[target.parentID]
+	parent.children = replaceSibling(parent.children, target.tree.Id, replacement)
+}
+
+// depthOf는 숲에서 해당 트리까지의 경로 길이를 반환합니다. (루트 트리 = 1)
+func (s *MemoryForestStore) depthOf(treeID string) int32 {
+	var depth int32
+	for id := treeID; id != ""; id = s.trees[id].parentID {
+		depth++
+	}
+	return depth
+}
+
+// maxDepthOf는 treeID를 루트로 하는 서브트리에서 휴지통에 없는 가장 깊은 트리의 depth를 반환합니다.
+func (s *MemoryForestStore) maxDepthOf(treeID string) int32 {
+	if treeID == "" {
+		return 0
+	}
+	var deepest int32
+	for _, childID := range s.liveChildrenOf(s.trees[treeID]) {
+		if d := s.maxDepthOf(childID); d > deepest {
+			deepest = d
+		}
+	}
+	return deepest + 1
+}
+
+// descendantsOf는 treeID의 모든 하위 트리 ID(휴지통 포함)를 전위 순회 순서로 반환합니다.
+func (s *MemoryForestStore) descendantsOf(treeID string) []string {
+	var ids []string
+	for _, childID := range s.trees[treeID].children {
+		ids = append(ids, childID)
+		ids = append(ids, s.descendantsOf(childID)...)
+	}
+	return ids
+}
+
+// liveDescendantsOf는 treeID의 하위 트리 중 휴지통에 없는 트리 ID를 전위 순회 순서로 반환합니다.
+func (s *MemoryForestStore) liveDescendantsOf(treeID string) []string {
+	var ids []string
+	for _, childID := range s.liveChildrenOf(s.trees[treeID]) {
+		ids = append(ids, childID)
+		ids = append(ids, s.liveDescendantsOf(childID)...)
+	}
+	return ids
+}
+
+func (s *MemoryForestStore) buildForest(f *memoryForest, includeChildren bool) *models.Forest {
+	forest := f.forest
+	forest.Root = nil
+	if t, ok := s.trees[f.rootID]; ok && t.deletedAt.IsZero() {
+		forest.Root = s.buildTree(f.rootID, includeChildren)
+	}
+	return &forest
+}
+
+func (s *MemoryForestStore) buildTree(treeID string, includeChildren bool) *models.Tree {
+	return s.buildSubtree(treeID, subtreeDepth(includeChildren, 0))
+}
+
+// buildSubtree는 depth 깊이까지 하위 트리를 포함해 트리를 만듭니다. depth가 음수면 제한하지 않습니다.
+func (s *MemoryForestStore) buildSubtree(treeID string, depth int) *models.Tree {
+	t := s.trees[treeID]
+	tree := t.tree
+	tree.Children = nil
+	if depth != 0 {
+		for _, childID := range s.liveChildrenOf(t) {
+			tree.Children = append(tree.Children, s.buildSubtree(childID, depth-1))
+		}
+	}
+	return &tree
+}
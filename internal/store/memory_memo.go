@@ -0,0 +1,120 @@
+package store
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+// MemoryMemoStore는 DB 없이 동작하는 MemoStore 구현체입니다.
+type MemoryMemoStore struct {
+	mu    sync.Mutex
+	memos map[memoKey]models.Memo
+}
+
+type memoKey struct {
+	userID string
+	treeID string
+}
+
+func NewMemoryMemoStore() *MemoryMemoStore {
+	return &MemoryMemoStore{
+		memos: map[memoKey]models.Memo{},
+	}
+}
+
+func (s *MemoryMemoStore) CreateMemo(ctx context.Context, userID string, treeID string, options map[string]interface{}) (*models.Memo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := memoKey{userID: userID, treeID: treeID}
+	if _, exists := s.memos[key]; exists {
+		return nil, errors.New("memo already exists")
+	}
+	memo := newMemoFromOptions(userID, treeID, options)
+	s.memos[key] = *memo
+	return memo, nil
+}
+
+func (s *MemoryMemoStore) GetMemo(ctx context.Context, userID string, treeID string) (*models.Memo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	memo, ok := s.memos[memoKey{userID: userID, treeID: treeID}]
+	if !ok {
+		return nil, ErrMemoNotFound
+	}
+	return &memo, nil
+}
+
+func (s *MemoryMemoStore) GetMemos(ctx context.Context, userID string, treeIDs []string) ([]*models.Memo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var memos []*models.Memo
+	for _, treeID := range treeIDs {
+		if memo, ok := s.memos[memoKey{userID: userID, treeID: treeID}]; ok {
+			memos = append(memos, &memo)
+		}
+	}
+	return memos, nil
+}
+
+func (s *MemoryMemoStore) UpdateMemo(ctx context.Context, userID string, treeID string, content string, expectedVersion int32) (*models.Memo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := memoKey{userID: userID, treeID: treeID}
+	memo, ok := s.memos[key]
+	if !ok {
+		return nil, ErrMemoNotFound
+	}
+	if memo.Version != expectedVersion {
+		return nil, ErrMemoVersionConflict
+	}
+	memo.Content = content
+	memo.Version = expectedVersion + 1
+	memo.UpdatedAt = time.Now().UTC()
+	s.memos[key] = memo
+	return &memo, nil
+}
+
+func (s *MemoryMemoStore) DeleteMemo(ctx context.Context, userID string, treeID string) (*models.Memo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := memoKey{userID: userID, treeID: treeID}
+	memo, ok := s.memos[key]
+	if !ok {
+		return nil, ErrMemoNotFound
+	}
+	delete(s.memos, key)
+	return &memo, nil
+}
+
+func (s *MemoryMemoStore) SearchMemos(ctx context.Context, userIDs []string, terms []string, offset int, limit int) ([]*models.Memo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var memos []*models.Memo
+	for key, memo := range s.memos {
+		if slices.Contains(userIDs, key.userID) && containsTerms(memo.Content, terms) == len(terms) {
+			memos = append(memos, &memo)
+		}
+	}
+	// Postgres와 같이 (user_id, tree_id) 순으로 자름
+	slices.SortFunc(memos, func(a, b *models.Memo) int {
+		return cmp.Or(strings.Compare(a.UserID, b.UserID), strings.Compare(a.TreeID, b.TreeID))
+	})
+	memos = memos[min(offset, len(memos)):]
+	if len(memos) > limit {
+		memos = memos[:limit]
+	}
+	return memos, nil
+}
@@ -0,0 +1,6 @@
+-- memo 테이블에 생성/수정 시각 컬럼을 추가합니다.
+-- 여러 번 실행해도 안전하며, 기존 행은 실행 시각으로 채워집니다.
+-- PostgresMemoStore는 시작할 때 자동으로 실행하고, Supabase는 SQL 편집기에서 한 번 실행해야 합니다.
+ALTER TABLE memo
+	ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
@@ -2,7 +2,10 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/jdk829355/InForest_back/config"
@@ -37,6 +40,21 @@ func InitNeo4jStore(cfg *config.Config) (*neo4j.DriverWithContext, error) {
 	// neo4j 드라이버 연결 테스트
 	for i := 0; i < 6; i++ {
 		if err := driver.VerifyConnectivity(ctx); err == nil {
+			// 검색과 URL 조회에 쓰는 인덱스가 없으면 생성
+			for _, index := range neo4jIndexes {
+				_, err := neo4j.ExecuteQuery(ctx, driver, index, nil, neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase("neo4j"))
+				if err != nil {
+					return nil, fmt.Errorf("failed to create index: %w", err)
+				}
+			}
+			// 생성/수정 시각이 없는 이전 데이터 채우기
+			now := neo4jTime(time.Now())
+			for _, backfill := range neo4jBackfills {
+				_, err := neo4j.ExecuteQuery(ctx, driver, backfill, map[string]any{"now": now}, neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase("neo4j"))
+				if err != nil {
+					return nil, fmt.Errorf("failed to backfill timestamps: %w", err)
+				}
+			}
 			return &driver, nil
 		}
 		time.Sleep(5 * time.Second)
@@ -50,57 +68,129 @@ func (store *Neo4jStore) Close(ctx context.Context) error {
 
 // 로직 시작
 
-func (s *Neo4jStore) GetForestByUser(ctx context.Context, userID string, includeChildren bool) ([]*models.Forest, error) {
+const treeSearchIndex = "tree_search"
+
+var neo4jIndexes = []string{
+	`CREATE FULLTEXT INDEX ` + treeSearchIndex + ` IF NOT EXISTS FOR (t:Tree) ON EACH [t.name, t.url, t.summary]`,
+	// FindTreesByUrl은 정규화한 URL로, canonical_url이 없는 이전 트리는 url로 찾음
+	`CREATE INDEX tree_canonical_url IF NOT EXISTS FOR (t:Tree) ON (t.canonical_url)`,
+	`CREATE INDEX tree_url IF NOT EXISTS FOR (t:Tree) ON (t.url)`,
+}
+
+// neo4jBackfills는 시각 필드가 없는 이전 노드를 채우는 쿼리입니다. 이미 채워진 노드는 건드리지 않습니다.
+// 실제 생성 시각을 알 수 없는 숲은 현재 시각($now)으로, 트리는 속한 숲의 생성 시각으로 채웁니다.
+var neo4jBackfills = []string{
+	`MATCH (f:Forest) WHERE f.created_at IS NULL OR f.updated_at IS NULL
+	WITH f, coalesce(f.created_at, $now) AS created_at
+	SET f.created_at = created_at, f.updated_at = coalesce(f.updated_at, created_at)`,
+	`MATCH (t:Tree) WHERE t.created_at IS NULL OR t.updated_at IS NULL
+	OPTIONAL MATCH (f:Forest)-[:derived*]->(t)
+	WITH t, coalesce(t.created_at, min(f.created_at), $now) AS created_at
+	SET t.created_at = created_at, t.updated_at = coalesce(t.updated_at, created_at)`,
+}
+
+// searchScopeFilter는 검색 범위에 있는 숲 f만 남기는 조건입니다.
+const searchScopeFilter = `($forest_id = "" OR f.id = $forest_id)
+	AND (($scope <> "shared" AND f.user_id = $user_id)
+		OR ($scope <> "owned" AND EXISTS { (:User {id: $user_id})-[:member_of]->(f) }))`
+
+// forestSortExpressions는 정렬 기준별 Cypher 식입니다. 시각이 없는 이전 데이터는 가장 오래된 것으로 취급합니다.
+var forestSortExpressions = map[ForestSort]string{
+	ForestSortName:      "f.name",
+	ForestSortCreatedAt: "coalesce(f.created_at, datetime({epochMillis: 0}))",
+	ForestSortUpdatedAt: "coalesce(f.updated_at, f.created_at, datetime({epochMillis: 0}))",
+}
+
+func (s *Neo4jStore) GetForestByUser(ctx context.Context, userID string, opts ForestListOptions) ([]*models.Forest, string, error) {
+	opts, err := opts.normalize()
+	if err != nil {
+		return nil, "", err
+	}
+	cursor, err := decodeForestCursor(opts.PageToken, opts)
+	if err != nil {
+		return nil, "", err
+	}
 	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
 	defer session.Close(ctx)
-	cypher := `MATCH (f:Forest {user_id: $user_id}) RETURN f.user_id AS user_id, f.id AS id, f.name AS name, f.description AS description, f.depth AS depth, f.total_trees AS total_trees`
+
+	direction, comparison := "ASC", ">"
+	if opts.Descending {
+		direction, comparison = "DESC", "<"
+	}
+	// 필터, 커서, 정렬, 페이지 크기를 모두 쿼리에서 처리하고 다음 페이지 확인을 위해 하나 더 조회
+	// 숲과 루트 트리를 한 번에 조회
+	// include_shared면 공유받은 숲도 포함
+	cypher := `CALL {
+		MATCH (f:Forest {user_id: $user_id}) RETURN f
+		UNION
+		MATCH (:User {id: $user_id})-[:member_of]->(f:Forest) WHERE $include_shared RETURN f
+	}
+	WITH f WHERE f.deleted_at IS NULL AND f.name STARTS WITH $name_prefix AND f.total_trees >= $min_trees
+	WITH f, ` + forestSortExpressions[opts.SortBy] + ` AS sort_key
+	WHERE $after_id IS NULL OR sort_key ` + comparison + ` $after_key OR (sort_key = $after_key AND f.id ` + comparison + ` $after_id)
+	ORDER BY sort_key ` + direction + `, f.id ` + direction + `
+	LIMIT $limit
+	OPTIONAL MATCH (f)-[:derived]->(t:Tree) WHERE t.deleted_at IS NULL
+	RETURN f.user_id AS user_id, f.id AS id, f.name AS name, f.description AS description, f.depth AS depth, f.total_trees AS total_trees,
+		[(f)-[:tagged]->(g:Tag) | g.name] AS tags,
+		f.created_at AS created_at, f.updated_at AS updated_at, sort_key,
+		` + treeMap("t") + ` AS root
+	ORDER BY sort_key ` + direction + `, id ` + direction
 	parameters := map[string]interface{}{
-		"user_id": userID,
+		"user_id":        userID,
+		"include_shared": opts.IncludeShared,
+		"name_prefix":    opts.NamePrefix,
+		"min_trees":      opts.MinTrees,
+		"limit":          opts.PageSize + 1,
+		"after_key":      nil,
+		"after_id":       nil,
+	}
+	if cursor != nil {
+		parameters["after_key"] = cursor.sortValue()
+		parameters["after_id"] = cursor.ID
 	}
 	result, err := session.Run(ctx, cypher, parameters)
 	if err != nil {
-		return nil, fmt.Errorf("failed to run query: %w", err)
+		return nil, "", fmt.Errorf("failed to run query: %w", err)
 	}
 	var forests []*models.Forest
+	var roots []*models.Tree
+	nextPageToken := ""
+	lastKey := ""
 
 	for result.Next(ctx) {
 		record := result.Record()
+		if len(forests) == opts.PageSize {
+			nextPageToken = encodeForestCursor(opts, lastKey, forests[len(forests)-1].Id)
+			break
+		}
 		forest, err := s.parseForestRecord(record)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse forest record: %w", err)
-		}
-		forests = append(forests, forest)
-	}
-
-	for i := range forests {
-		cypher = `MATCH (f: Forest{id: $forestId})-[:derived]->(t: Tree) RETURN t.id AS id, t.name AS name, t.url AS url`
-		parameters = map[string]interface{}{
-			"forestId": forests[i].Id,
+			return nil, "", fmt.Errorf("failed to parse forest record: %w", err)
 		}
-		treeResult, err := session.Run(ctx, cypher, parameters)
+		forest.Root, err = s.parseRootTree(record)
 		if err != nil {
-			return nil, fmt.Errorf("failed to run tree query: %w", err)
+			return nil, "", fmt.Errorf("failed to parse tree record: %w", err)
 		}
-		if treeResult.Next(ctx) {
-			if record := treeResult.Record(); record != nil {
-				rootTree, err := s.parseTreeRecord(record)
-				if err != nil {
-					return nil, fmt.Errorf("failed to parse tree record: %w", err)
-				}
-				forests[i].Root = rootTree
-			}
+		if forest.Root != nil {
+			roots = append(roots, forest.Root)
 		}
+		sortKey, _ := record.Get("sort_key")
+		lastKey = forestSortKey(sortKey)
+		forests = append(forests, forest)
 	}
-	if includeChildren {
-		for _, forest := range forests {
-			err = getDerived(forest.Root, ctx, session, s)
-			if err != nil {
-				return nil, err
-			}
+	if err := result.Err(); err != nil {
+		return nil, "", fmt.Errorf("failed to run query: %w", err)
+	}
+
+	// 모든 숲의 하위 트리를 한 번의 쿼리로 가져오기
+	if opts.IncludeChildren {
+		if err := s.getDerived(ctx, sessionRunner{session}, 0, roots...); err != nil {
+			return nil, "", err
 		}
 	}
 
-	return forests, nil
+	return forests, nextPageToken, nil
 }
 
 func (s *Neo4jStore) CreateForest(ctx context.Context, forest *models.Forest, root *models.Tree) error {
@@ -110,75 +200,98 @@ func (s *Neo4jStore) CreateForest(ctx context.Context, forest *models.Forest, ro
 	forest.Id = uuid.New().String()
 	forest.Depth = 1
 	forest.TotalTrees = 1
+	forest.CreatedAt = neo4jTime(time.Now())
+	forest.UpdatedAt = forest.CreatedAt
 	root.Id = uuid.New().String()
 	root.Children = nil
+	root.CreatedAt = forest.CreatedAt
+	root.UpdatedAt = forest.CreatedAt
 
-	cypher := `CREATE (f:Forest {id: $id, name: $name, description: $description, depth: $depth, total_trees: $total_trees, user_id: $user_id})
-	-[:derived]-> (t:Tree {id: $tree_id, name: $tree_name, url: $tree_url, summary: ""})`
+	cypher := `CREATE (f:Forest {id: $id, name: $name, description: $description, depth: $depth, total_trees: $total_trees, user_id: $user_id,
+		created_at: $now, updated_at: $now})
+	-[:derived]-> (t:Tree {id: $tree_id, name: $tree_name, url: $tree_url, canonical_url: $tree_canonical_url, summary: "", created_at: $now, updated_at: $now})`
 	parameters := map[string]interface{}{
-		"id":          forest.Id,
-		"name":        forest.Name,
-		"description": forest.Description,
-		"depth":       forest.Depth,
-		"total_trees": forest.TotalTrees,
-		"user_id":     forest.UserId,
-		"tree_id":     root.Id,
-		"tree_name":   root.Name,
-		"tree_url":    root.Url,
+		"now":                neo4jTime(forest.CreatedAt),
+		"id":                 forest.Id,
+		"name":               forest.Name,
+		"description":        forest.Description,
+		"depth":              forest.Depth,
+		"total_trees":        forest.TotalTrees,
+		"user_id":            forest.UserId,
+		"tree_id":            root.Id,
+		"tree_name":          root.Name,
+		"tree_url":           root.Url,
+		"tree_canonical_url": root.CanonicalUrl,
 	}
-	_, err := session.Run(ctx, cypher, parameters)
-	if err != nil {
-		return err
-	}
-	return nil
+	_, err := neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, err := tx.Run(ctx, cypher, parameters)
+		return nil, err
+	})
+	return err
 }
 
-func (s *Neo4jStore) CreateTree(ctx context.Context, tree *models.Tree, parentID string) (string, error) {
+func (s *Neo4jStore) CreateTree(ctx context.Context, tree *models.Tree, parentID string, index int) (string, error) {
 	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
 	defer session.Close(ctx)
 
 	tree.Id = uuid.New().String()
 	tree.Children = nil
+	tree.CreatedAt = neo4jTime(time.Now())
+	tree.UpdatedAt = tree.CreatedAt
 
-	cypher := `MATCH (parent:Tree {id: $parent_id})
-	CREATE (child:Tree {id: $id, name: $name, url: $url, summary: ""})
-	CREATE (parent)-[:derived]->(child)`
+	// 트리 생성과 부모 트리의 숲 정보 업데이트를 하나의 쿼리로 처리
+	cypher := `MATCH p = (f:Forest)-[:derived*]->(parent:Tree {id: $parent_id})
+	WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+	WITH f, parent, length(p) AS parent_depth
+	CREATE (parent)-[:derived]->(child:Tree {id: $id, name: $name, url: $url, canonical_url: $canonical_url, summary: "", created_at: $now, updated_at: $now})
+	SET f.total_trees = f.total_trees + 1, f.updated_at = $now
+	SET f.depth = CASE
+					WHEN (parent_depth + 1) > f.depth THEN (parent_depth + 1)
+					ELSE f.depth
+				  END
+	RETURN child.id AS id`
 	parameters := map[string]interface{}{
-		"id":        tree.Id,
-		"name":      tree.Name,
-		"url":       tree.Url,
-		"parent_id": parentID,
-	}
-	_, err := session.Run(ctx, cypher, parameters)
-	if err != nil {
-		return "", err
-	}
-
-	// 부모 트리의 숲 정보 업데이트
-	cypher = `
-	MATCH p = (f:Forest)-[:derived*]->(parent:Tree {id: $parent_id})
-            WITH f, length(p) AS parent_depth
-            SET f.total_trees = f.total_trees + 1
-            SET f.depth = CASE 
-                            WHEN (parent_depth + 1) > f.depth THEN (parent_depth + 1) 
-                            ELSE f.depth 
-                          END
-`
-	parameters = map[string]interface{}{
-		"parent_id": parentID,
+		"id":            tree.Id,
+		"name":          tree.Name,
+		"url":           tree.Url,
+		"canonical_url": tree.CanonicalUrl,
+		"parent_id":     parentID,
+		"now":           neo4jTime(tree.CreatedAt),
 	}
-	_, err = session.Run(ctx, cypher, parameters)
+	_, err := neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (any, error) {
+		children, liveChildren, err := childOrder(ctx, tx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		result, err := tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, err
+		}
+		if !result.Next(ctx) {
+			if err := result.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrTreeNotFound
+		}
+		// 요청한 위치에 끼워 넣고 형제 순서 저장
+		return nil, setChildOrder(ctx, tx, parentID, insertChild(children, liveChildren, tree.Id, index))
+	})
 	if err != nil {
 		return "", err
 	}
 	return tree.Id, nil
 }
 
-func (s *Neo4jStore) GetForest(ctx context.Context, forestID string, include_children bool) (*models.Forest, error) {
+func (s *Neo4jStore) GetForest(ctx context.Context, forestID string, include_children bool, maxDepth int) (*models.Forest, error) {
 	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
 	defer session.Close(ctx)
 
-	cypher := `MATCH (f:Forest {id: $forest_id}) RETURN f.user_id AS user_id, f.id AS id, f.name AS name, f.description AS description, f.depth AS depth, f.total_trees AS total_trees`
+	cypher := `MATCH (f:Forest {id: $forest_id}) WHERE f.deleted_at IS NULL
+	OPTIONAL MATCH (f)-[:derived]->(t:Tree) WHERE t.deleted_at IS NULL
+	RETURN f.user_id AS user_id, f.id AS id, f.name AS name, f.description AS description, f.depth AS depth, f.total_trees AS total_trees,
+		[(f)-[:tagged]->(g:Tag) | g.name] AS tags,
+		f.created_at AS created_at, f.updated_at AS updated_at,
+		` + treeMap("t") + ` AS root`
 	parameters := map[string]interface{}{
 		"forest_id": forestID,
 	}
@@ -186,150 +299,191 @@ func (s *Neo4jStore) GetForest(ctx context.Context, forestID string, include_chi
 	if err != nil {
 		return nil, fmt.Errorf("failed to run query: %w", err)
 	}
-	if result.Next(ctx) {
-		record := result.Record()
-		forest, err := s.parseForestRecord(record)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse forest record: %w", err)
-		}
-		// 루트 트리의 하위 트리들 재귀적으로 가져오기
-		cypher = `MATCH (f: Forest{id: $forestId})-[:derived]->(t: Tree) RETURN t.id AS id, t.name AS name, t.url AS url, t.summary AS summary`
-		parameters = map[string]interface{}{
-			"forestId": forest.Id,
-		}
-		treeResult, err := session.Run(ctx, cypher, parameters)
-		if err != nil {
-			return nil, fmt.Errorf("failed to run tree query: %w", err)
-		}
-
-		var rootTree *models.Tree
-
-		if treeResult.Next(ctx) {
-			if record := treeResult.Record(); record != nil {
-				rootTree, err = s.parseTreeRecord(record)
-				if err != nil {
-					return nil, fmt.Errorf("failed to parse tree record: %w", err)
-				}
-				forest.Root = rootTree
-			}
+	if !result.Next(ctx) {
+		if err := result.Err(); err != nil {
+			return nil, fmt.Errorf("failed to run query: %w", err)
 		}
+		return nil, ErrForestNotFound
+	}
+	record := result.Record()
+	forest, err := s.parseForestRecord(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse forest record: %w", err)
+	}
+	forest.Root, err = s.parseRootTree(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tree record: %w", err)
+	}
 
-		if include_children {
-			err = getDerived(rootTree, ctx, session, s)
-			if err != nil {
-				return nil, err
-			}
+	// 루트 트리의 하위 트리들 한 번에 가져오기
+	if include_children && forest.Root != nil {
+		if err := s.getDerived(ctx, sessionRunner{session}, maxDepth, forest.Root); err != nil {
+			return nil, err
 		}
-
-		return forest, nil
 	}
-	return nil, fmt.Errorf("forest not found")
+	return forest, nil
 }
 
-func (s *Neo4jStore) UpdateForest(ctx context.Context, forest *models.Forest) (models.Forest, error) {
+func (s *Neo4jStore) UpdateForest(ctx context.Context, forest *models.Forest, fields []string) (models.Forest, error) {
+	if err := checkUpdate(forest.Id, fields, forestUpdateFields); err != nil {
+		return models.Forest{}, err
+	}
 	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
 	defer session.Close(ctx)
 
-	if forest.Name+forest.Id == "" {
-		return models.Forest{}, fmt.Errorf("invalid forest data")
+	values := map[string]interface{}{
+		"name":        forest.Name,
+		"description": forest.Description,
 	}
-
-	cypher := `MATCH (f:Forest {id: $id})`
+	cypher := `MATCH (f:Forest {id: $id}) WHERE f.deleted_at IS NULL`
 	parameters := map[string]interface{}{}
-	if forest.Name != "" {
-		cypher += ` SET f.name = $name`
-		parameters["name"] = forest.Name
-	}
-	if forest.Description != "" {
-		cypher += ` SET f.description = $description`
-		parameters["description"] = forest.Description
+	// 속성 이름은 forestUpdateFields에 있는 값만 쓰므로 쿼리에 직접 넣어도 안전함
+	for _, field := range fields {
+		cypher += ` SET f.` + forestUpdateFields[field] + ` = $` + field
+		parameters[field] = values[field]
 	}
+	cypher += ` SET f.updated_at = $now`
+	cypher += ` RETURN f.user_id AS user_id, f.id AS id, f.name AS name, f.description AS description, f.depth AS depth, f.total_trees AS total_trees, [(f)-[:tagged]->(g:Tag) | g.name] AS tags, f.created_at AS created_at, f.updated_at AS updated_at`
 	parameters["id"] = forest.Id
-	_, err := session.Run(ctx, cypher, parameters)
+	parameters["now"] = neo4jTime(time.Now())
+
+	updatedForest, err := neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (*models.Forest, error) {
+		result, err := tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, err
+		}
+		if !result.Next(ctx) {
+			if err := result.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrForestNotFound
+		}
+		return s.parseForestRecord(result.Record())
+	})
 	if err != nil {
 		return models.Forest{}, err
 	}
-	updatedForest, err := s.GetForest(ctx, forest.Id, false)
-	if err == nil {
-		return *updatedForest, nil
-	}
-
-	return models.Forest{}, fmt.Errorf("forest not found")
+	return *updatedForest, nil
 }
 
 func (s *Neo4jStore) DeleteForest(ctx context.Context, forestID string) ([]string, error) {
 	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
 	defer session.Close(ctx)
-	cypher := `MATCH (f:Forest {id: $forest_id}) -[*]-> (t:Tree) return t.id AS id`
 	parameters := map[string]interface{}{
 		"forest_id": forestID,
+		"now":       neo4jTime(time.Now()),
 	}
-	result, err := session.Run(ctx, cypher, parameters)
-	if err != nil {
-		return nil, err
-	}
-	idsToDelete := []string{}
-	for result.Next(ctx) {
-		record := result.Record()
-		treeID, ok := record.Get("id")
-		if !ok {
-			return nil, fmt.Errorf("failed to get tree id from record: %v", record)
+
+	// 숲을 휴지통으로 옮기고 숲에 남아 있던 트리 ID 반환
+	return neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) ([]string, error) {
+		cypher := `MATCH (f:Forest {id: $forest_id}) WHERE f.deleted_at IS NULL
+		OPTIONAL MATCH p = (f)-[:derived*]->(t:Tree) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+		WITH f, collect(t.id) AS ids
+		SET f.deleted_at = $now
+		RETURN ids`
+		result, err := tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, err
 		}
-		if treeID == nil {
-			continue
-		} else {
-			fmt.Println("tree id 불러오는 중..")
-			idsToDelete = append(idsToDelete, treeID.(string))
+		if !result.Next(ctx) {
+			if err := result.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrForestNotFound
 		}
-	}
-
-	cypher = `MATCH (n:Forest {id: $forest_id}) OPTIONAL MATCH (n)-[*]->(d:Tree) DETACH DELETE n, d`
-	parameters = map[string]interface{}{
-		"forest_id": forestID,
-	}
-	_, err = session.Run(ctx, cypher, parameters)
-	if err != nil {
-		return nil, err
-	}
-	return idsToDelete, nil
+		return getStringList(result.Record(), "ids")
+	})
 }
 
-func (s *Neo4jStore) UpdateTree(ctx context.Context, tree *models.Tree) (models.Tree, error) {
+func (s *Neo4jStore) UpdateTree(ctx context.Context, tree *models.Tree, fields []string) (models.Tree, error) {
+	if err := checkUpdate(tree.Id, fields, treeUpdateFields); err != nil {
+		return models.Tree{}, err
+	}
 	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
 	defer session.Close(ctx)
 
-	if tree.Name+tree.Id == "" {
-		return models.Tree{}, fmt.Errorf("invalid tree data")
+	values := map[string]interface{}{
+		"name": tree.Name,
+		"url":  tree.Url,
 	}
-
-	cypher := `MATCH (t:Tree {id: $id})`
+	cypher := `MATCH p = (:Forest)-[:derived*]->(t:Tree {id: $id}) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+	WITH t`
 	parameters := map[string]interface{}{}
-	if tree.Name != "" {
-		cypher += ` SET t.name = $name`
-		parameters["name"] = tree.Name
-	}
-	if tree.Url != "" {
-		cypher += ` SET t.url = $url`
-		parameters["url"] = tree.Url
+	for _, field := range fields {
+		cypher += ` SET t.` + treeUpdateFields[field] + ` = $` + field
+		parameters[field] = values[field]
+		// url을 바꾸면 정규화한 url도 함께 저장
+		if field == "url" {
+			cypher += ` SET t.canonical_url = $canonical_url`
+			parameters["canonical_url"] = tree.CanonicalUrl
+		}
 	}
+	cypher += ` SET t.updated_at = $now`
+	cypher += ` RETURN ` + treeColumns("t")
 	parameters["id"] = tree.Id
-	_, err := session.Run(ctx, cypher, parameters)
+	parameters["now"] = neo4jTime(time.Now())
+
+	updatedTree, err := neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (*models.Tree, error) {
+		result, err := tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, err
+		}
+		if !result.Next(ctx) {
+			if err := result.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrTreeNotFound
+		}
+		return s.parseTreeRecord(result.Record())
+	})
 	if err != nil {
 		return models.Tree{}, err
 	}
-	updatedTree, err := s.GetTreeByID(ctx, tree.Id, false)
-	if err == nil {
-		return *updatedTree, nil
+	return *updatedTree, nil
+}
+
+func (s *Neo4jStore) RecordVisit(ctx context.Context, visit *models.Visit) (*models.Tree, error) {
+	if visit.DwellMs < 0 {
+		return nil, ErrInvalidVisit
 	}
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
 
-	return models.Tree{}, fmt.Errorf("tree not found")
+	visit.Id = uuid.New().String()
+	return neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (*models.Tree, error) {
+		cypher := `MATCH p = (:Forest)-[:derived*]->(t:Tree {id: $tree_id}) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+		CREATE (t)-[:visited]->(:Visit {id: $id, user_id: $user_id, visited_at: $visited_at, dwell_ms: $dwell_ms})
+		SET t.first_visited_at = CASE WHEN t.first_visited_at IS NULL OR $visited_at < t.first_visited_at THEN $visited_at ELSE t.first_visited_at END,
+			t.last_visited_at = CASE WHEN t.last_visited_at IS NULL OR $visited_at > t.last_visited_at THEN $visited_at ELSE t.last_visited_at END,
+			t.visit_count = coalesce(t.visit_count, 0) + 1,
+			t.total_dwell_ms = coalesce(t.total_dwell_ms, 0) + $dwell_ms
+		RETURN ` + treeColumns("t")
+		result, err := tx.Run(ctx, cypher, map[string]interface{}{
+			"tree_id":    visit.TreeId,
+			"id":         visit.Id,
+			"user_id":    visit.UserId,
+			"visited_at": neo4jTime(visit.VisitedAt),
+			"dwell_ms":   visit.DwellMs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !result.Next(ctx) {
+			if err := result.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrTreeNotFound
+		}
+		return s.parseTreeRecord(result.Record())
+	})
 }
 
-func (s *Neo4jStore) GetTreeByID(ctx context.Context, treeID string, includeChildren bool) (*models.Tree, error) {
+func (s *Neo4jStore) GetTreeByID(ctx context.Context, treeID string, includeChildren bool, maxDepth int) (*models.Tree, error) {
 	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
 	defer session.Close(ctx)
 
-	cypher := `MATCH (t:Tree {id: $tree_id}) RETURN t.id AS id, t.name AS name, t.url AS url, t.summary AS summary`
+	cypher := `MATCH p = (:Forest)-[:derived*]->(t:Tree {id: $tree_id}) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+	RETURN ` + treeColumns("t")
 	parameters := map[string]interface{}{
 		"tree_id": treeID,
 	}
@@ -344,132 +498,1601 @@ func (s *Neo4jStore) GetTreeByID(ctx context.Context, treeID string, includeChil
 			return nil, fmt.Errorf("failed to parse tree record: %w", err)
 		}
 		if includeChildren {
-			err = getDerived(tree, ctx, session, s)
-			if err != nil {
+			if err := s.getDerived(ctx, sessionRunner{session}, maxDepth, tree); err != nil {
 				return nil, err
 			}
 		}
 		return tree, nil
 	}
-	return nil, fmt.Errorf("tree not found")
+	return nil, ErrTreeNotFound
 }
 
-func (s *Neo4jStore) DeleteTree(ctx context.Context, treeID string, cascade bool) ([]string, error) {
+func (s *Neo4jStore) FindDuplicateTree(ctx context.Context, forestID string, canonicalURL string) (*models.Tree, error) {
 	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
 	defer session.Close(ctx)
-	var cypher string
-	var inspectCypher string
-	var deletedId []string
-
-	deletedId = append(deletedId, treeID)
 
-	if cascade {
-		inspectCypher = `MATCH (t:Tree {id: $tree_id})
-		OPTIONAL MATCH (t)-[*]->(descendants)
-		RETURN descendants.id as deletedId `
-		parameters := map[string]interface{}{
-			"tree_id": treeID,
+	cypher := `MATCH p = (:Forest {id: $forest_id})-[:derived*]->(t:Tree) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+	AND coalesce(t.canonical_url, t.url) = $canonical_url
+	RETURN ` + treeColumns("t") + `
+	ORDER BY name, id
+	LIMIT 1`
+	result, err := session.Run(ctx, cypher, map[string]interface{}{
+		"forest_id":     forestID,
+		"canonical_url": canonicalURL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to run query: %w", err)
+	}
+	if !result.Next(ctx) {
+		if err := result.Err(); err != nil {
+			return nil, fmt.Errorf("failed to run query: %w", err)
 		}
+		return nil, ErrTreeNotFound
+	}
+	tree, err := s.parseTreeRecord(result.Record())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tree record: %w", err)
+	}
+	return tree, nil
+}
 
-		resp, err := session.Run(ctx, inspectCypher, parameters)
-		if err != nil {
-			return nil, err
+func (s *Neo4jStore) GetForestOwner(ctx context.Context, forestID string) (string, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	cypher := `MATCH (f:Forest {id: $forest_id}) RETURN f.user_id AS user_id`
+	result, err := session.Run(ctx, cypher, map[string]interface{}{"forest_id": forestID})
+	if err != nil {
+		return "", fmt.Errorf("failed to run query: %w", err)
+	}
+	if !result.Next(ctx) {
+		if err := result.Err(); err != nil {
+			return "", fmt.Errorf("failed to run query: %w", err)
 		}
+		return "", ErrForestNotFound
+	}
+	userID, _, err := neo4j.GetRecordValue[string](result.Record(), "user_id")
+	if err != nil {
+		return "", fmt.Errorf("failed to parse owner record: %w", err)
+	}
+	return userID, nil
+}
 
-		for resp.Next(ctx) {
-			record := resp.Record()
-			id, ok := record.Get("deletedId")
-			if !ok {
-				return nil, fmt.Errorf("failed to get deleted id from record: %v", record)
-			}
-			if id == nil {
-				break
-			} else {
-				fmt.Println("deletedId 불러오는 중..")
-				deletedId = append(deletedId, id.(string))
-			}
+func (s *Neo4jStore) GetTreeOwner(ctx context.Context, treeID string) (string, string, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	cypher := `MATCH (f:Forest)-[:derived*]->(:Tree {id: $tree_id})
+	RETURN f.id AS forest_id, f.user_id AS user_id LIMIT 1`
+	result, err := session.Run(ctx, cypher, map[string]interface{}{"tree_id": treeID})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to run query: %w", err)
+	}
+	if !result.Next(ctx) {
+		if err := result.Err(); err != nil {
+			return "", "", fmt.Errorf("failed to run query: %w", err)
 		}
+		return "", "", ErrTreeNotFound
+	}
+	record := result.Record()
+	forestID, _, err := neo4j.GetRecordValue[string](record, "forest_id")
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse owner record: %w", err)
+	}
+	userID, _, err := neo4j.GetRecordValue[string](record, "user_id")
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse owner record: %w", err)
 	}
+	return forestID, userID, nil
+}
 
-	// 숲에서 트리가 속한 숲 ID 조회
-	var forestId string
-	cypher = `MATCH (f: Forest)-[:derived*]->(t) WHERE t.id = $tree_id return f.id as forestId`
+func (s *Neo4jStore) GetMemberRole(ctx context.Context, forestID string, userID string) (models.ForestRole, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	cypher := `MATCH (f:Forest {id: $forest_id})
+	OPTIONAL MATCH (:User {id: $user_id})-[m:member_of]->(f)
+	RETURN m.role AS role`
 	parameters := map[string]interface{}{
-		"tree_id": treeID,
+		"forest_id": forestID,
+		"user_id":   userID,
 	}
-
-	resp, err := session.Run(ctx, cypher, parameters)
+	result, err := session.Run(ctx, cypher, parameters)
 	if err != nil {
-		return nil, err
+		return "", fmt.Errorf("failed to run query: %w", err)
 	}
+	if !result.Next(ctx) {
+		if err := result.Err(); err != nil {
+			return "", fmt.Errorf("failed to run query: %w", err)
+		}
+		return "", ErrForestNotFound
+	}
+	// 멤버가 아니면 role은 null
+	role, _, err := neo4j.GetRecordValue[string](result.Record(), "role")
+	if err != nil {
+		return "", fmt.Errorf("failed to parse member record: %w", err)
+	}
+	return models.ForestRole(role), nil
+}
 
-	if resp.Next(ctx) {
-		record := resp.Record()
-		id, ok := record.Get("forestId")
-		if !ok {
-			return nil, fmt.Errorf("failed to get forest id from record: %v", record)
+func (s *Neo4jStore) ShareForest(ctx context.Context, forestID string, userID string, role models.ForestRole) (*models.ForestMember, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	return neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (*models.ForestMember, error) {
+		forest, err := s.readForest(ctx, tx, forestID)
+		if err != nil {
+			return nil, err
 		}
-		if id == nil {
-			fmt.Println("forest 불러오는 중")
-			return nil, fmt.Errorf("forest not found for tree id: %s", treeID)
-		} else {
-			forestId = id.(string)
+		if err := checkShare(forest.UserId, userID, role); err != nil {
+			return nil, err
 		}
-	}
+		cypher := `MATCH (f:Forest {id: $forest_id})
+		MERGE (u:User {id: $user_id})
+		MERGE (u)-[m:member_of]->(f)
+		SET m.role = $role`
+		parameters := map[string]interface{}{
+			"forest_id": forestID,
+			"user_id":   userID,
+			"role":      string(role),
+		}
+		if _, err := tx.Run(ctx, cypher, parameters); err != nil {
+			return nil, fmt.Errorf("failed to run query: %w", err)
+		}
+		return &models.ForestMember{UserId: userID, Role: role}, nil
+	})
+}
 
-	if cascade {
-		cypher = `MATCH (t:Tree {id: $tree_id})
-		OPTIONAL MATCH (t)-[*]->(descendants)
-		DETACH DELETE t, descendants
-		return count(t) as deletedCount`
-	} else {
-		cypher = `MATCH (target:Tree {id:$tree_id})
-				OPTIONAL MATCH (parent)-[r:derived]->(target)
-				OPTIONAL MATCH (target)-[rd:derived]->(child)
-
-				// 부모와 자식이 모두 존재할 때만 새로운 관계 연결
-				WITH target, parent, child, r, rd
-				FOREACH (_ IN CASE WHEN parent IS NOT NULL AND child IS NOT NULL THEN [1] ELSE [] END |
-					CREATE (parent)-[newRel:derived]->(child)
-					// 필요 시 기존 관계의 속성을 복사 (선택 사항)
-					// SET newRel = properties(r) 
-				)
-
-				DETACH DELETE target
-				RETURN count(target) as deletedCount
-		`
-	}
-	parameters = map[string]interface{}{
-		"tree_id": treeID,
-	}
+func (s *Neo4jStore) UnshareForest(ctx context.Context, forestID string, userID string) error {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	_, err := neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (any, error) {
+		if _, err := s.readForest(ctx, tx, forestID); err != nil {
+			return nil, err
+		}
+		cypher := `MATCH (:User {id: $user_id})-[m:member_of]->(:Forest {id: $forest_id})
+		DELETE m
+		RETURN count(m) AS deleted`
+		parameters := map[string]interface{}{
+			"forest_id": forestID,
+			"user_id":   userID,
+		}
+		result, err := tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run query: %w", err)
+		}
+		record, err := result.Single(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run query: %w", err)
+		}
+		deleted, _, err := neo4j.GetRecordValue[int64](record, "deleted")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse member record: %w", err)
+		}
+		if deleted == 0 {
+			return nil, ErrMemberNotFound
+		}
+		return nil, nil
+	})
+	return err
+}
+
+func (s *Neo4jStore) ListForestMembers(ctx context.Context, forestID string) ([]*models.ForestMember, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
 
-	resp, err = session.Run(ctx, cypher, parameters)
+	cypher := `MATCH (f:Forest {id: $forest_id}) WHERE f.deleted_at IS NULL
+	OPTIONAL MATCH (u:User)-[m:member_of]->(f)
+	RETURN u.id AS user_id, m.role AS role
+	ORDER BY user_id`
+	result, err := session.Run(ctx, cypher, map[string]interface{}{"forest_id": forestID})
 	if err != nil {
+		return nil, fmt.Errorf("failed to run query: %w", err)
+	}
+	found := false
+	members := []*models.ForestMember{}
+	for result.Next(ctx) {
+		found = true
+		record := result.Record()
+		userID, isNil, err := neo4j.GetRecordValue[string](record, "user_id")
+		if isNil {
+			// 멤버가 없는 숲
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse member record: %w", err)
+		}
+		role, _, err := neo4j.GetRecordValue[string](record, "role")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse member record: %w", err)
+		}
+		members = append(members, &models.ForestMember{UserId: userID, Role: models.ForestRole(role)})
+	}
+	if err := result.Err(); err != nil {
+		return nil, fmt.Errorf("failed to run query: %w", err)
+	}
+	if !found {
+		return nil, ErrForestNotFound
+	}
+	return members, nil
+}
+
+func (s *Neo4jStore) CreateShareLink(ctx context.Context, forestID string, expiresAt time.Time) (*models.ShareLink, error) {
+	if err := checkShareLinkExpiry(expiresAt); err != nil {
 		return nil, err
 	}
+	token, hash, err := newShareToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create share token: %w", err)
+	}
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
 
-	if resp.Next(ctx) {
-		deletedCount, ok := resp.Record().Get("deletedCount")
-		if !ok || deletedCount.(int64) == 0 {
-			return nil, fmt.Errorf("tree not found")
-		}
+	link := &models.ShareLink{
+		Id:        uuid.New().String(),
+		ForestId:  forestID,
+		Token:     token,
+		ExpiresAt: neo4jTime(expiresAt),
+		CreatedAt: neo4jTime(time.Now()),
 	}
-	// 숲 정보 업데이트
-	cypher = `
-	MATCH (f:Forest {id: $forest_id})
-	MATCH p = (f)-[:derived*]->(t:Tree)
-	WITH f, max(length(p)) AS max_depth
-	SET f.total_trees = f.total_trees - $deleted_trees, f.depth = max_depth
-	RETURN f.id, f.depth, f.total_trees;
-	`
-	parameters = map[string]interface{}{
-		"forest_id":     forestId,
-		"deleted_trees": len(deletedId),
+	cypher := `MATCH (f:Forest {id: $forest_id}) WHERE f.deleted_at IS NULL
+	CREATE (l:ShareLink {id: $id, token_hash: $token_hash, expires_at: $expires_at, created_at: $created_at})-[:shares]->(f)
+	RETURN l.id AS id`
+	parameters := map[string]interface{}{
+		"forest_id":  forestID,
+		"id":         link.Id,
+		"token_hash": hash,
+		"expires_at": link.ExpiresAt,
+		"created_at": link.CreatedAt,
 	}
-	_, err = session.Run(ctx, cypher, parameters)
+	_, err = neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run query: %w", err)
+		}
+		if !result.Next(ctx) {
+			if err := result.Err(); err != nil {
+				return nil, fmt.Errorf("failed to run query: %w", err)
+			}
+			return nil, ErrForestNotFound
+		}
+		return nil, nil
+	})
 	if err != nil {
 		return nil, err
 	}
+	return link, nil
+}
+
+func (s *Neo4jStore) GetShareLink(ctx context.Context, token string) (*models.ShareLink, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
 
-	return deletedId, nil
+	cypher := `MATCH (l:ShareLink {token_hash: $token_hash})-[:shares]->(f:Forest)
+	WHERE l.expires_at > $now AND f.deleted_at IS NULL
+	RETURN l.id AS id, f.id AS forest_id, l.expires_at AS expires_at, l.created_at AS created_at`
+	result, err := session.Run(ctx, cypher, map[string]interface{}{
+		"token_hash": hashShareToken(token),
+		"now":        neo4jTime(time.Now()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to run query: %w", err)
+	}
+	if !result.Next(ctx) {
+		if err := result.Err(); err != nil {
+			return nil, fmt.Errorf("failed to run query: %w", err)
+		}
+		return nil, ErrShareLinkNotFound
+	}
+	link, err := parseShareLinkRecord(result.Record())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse share link record: %w", err)
+	}
+	return link, nil
+}
+
+func (s *Neo4jStore) ListShareLinks(ctx context.Context, forestID string) ([]*models.ShareLink, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	cypher := `MATCH (l:ShareLink)-[:shares]->(f:Forest {id: $forest_id})
+	WHERE l.expires_at > $now
+	RETURN l.id AS id, f.id AS forest_id, l.expires_at AS expires_at, l.created_at AS created_at`
+	result, err := session.Run(ctx, cypher, map[string]interface{}{
+		"forest_id": forestID,
+		"now":       neo4jTime(time.Now()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to run query: %w", err)
+	}
+	links := []*models.ShareLink{}
+	for result.Next(ctx) {
+		link, err := parseShareLinkRecord(result.Record())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse share link record: %w", err)
+		}
+		links = append(links, link)
+	}
+	if err := result.Err(); err != nil {
+		return nil, fmt.Errorf("failed to run query: %w", err)
+	}
+	sortShareLinks(links)
+	return links, nil
+}
+
+func (s *Neo4jStore) RevokeShareLink(ctx context.Context, forestID string, linkID string) error {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	cypher := `MATCH (l:ShareLink {id: $link_id})-[:shares]->(:Forest {id: $forest_id})
+	DETACH DELETE l
+	RETURN count(l) AS deleted`
+	parameters := map[string]interface{}{
+		"forest_id": forestID,
+		"link_id":   linkID,
+	}
+	_, err := neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run query: %w", err)
+		}
+		record, err := result.Single(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run query: %w", err)
+		}
+		if deleted, _, err := neo4j.GetRecordValue[int64](record, "deleted"); err != nil {
+			return nil, fmt.Errorf("failed to parse share link record: %w", err)
+		} else if deleted == 0 {
+			return nil, ErrShareLinkNotFound
+		}
+		return nil, nil
+	})
+	return err
+}
+
+func (s *Neo4jStore) AddForestTags(ctx context.Context, forestID string, tags []string) ([]string, error) {
+	return s.updateTags(ctx, "Forest", forestID, tags, true)
+}
+
+func (s *Neo4jStore) AddTreeTags(ctx context.Context, treeID string, tags []string) ([]string, error) {
+	return s.updateTags(ctx, "Tree", treeID, tags, true)
+}
+
+func (s *Neo4jStore) RemoveForestTags(ctx context.Context, forestID string, tags []string) ([]string, error) {
+	return s.updateTags(ctx, "Forest", forestID, tags, false)
+}
+
+func (s *Neo4jStore) RemoveTreeTags(ctx context.Context, treeID string, tags []string) ([]string, error) {
+	return s.updateTags(ctx, "Tree", treeID, tags, false)
+}
+
+// updateTags는 label이 Forest 또는 Tree인 노드에 태그를 붙이거나(add) 뗍니다.
+// 태그는 숲 소유자의 :Tag 노드로 공유되며, 떼어낸 뒤 아무 데도 붙어 있지 않은 태그 노드는 삭제합니다.
+func (s *Neo4jStore) updateTags(ctx context.Context, label string, id string, tags []string, add bool) ([]string, error) {
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+	parameters := map[string]interface{}{
+		"id":   id,
+		"tags": tags,
+		"now":  neo4jTime(time.Now()),
+	}
+
+	cypher := `MATCH (n:Forest {id: $id}) WHERE n.deleted_at IS NULL
+	WITH n, n.user_id AS user_id`
+	notFound := ErrForestNotFound
+	if label == "Tree" {
+		cypher = `MATCH p = (f:Forest)-[:derived*]->(n:Tree {id: $id}) WHERE all(x IN nodes(p) WHERE x.deleted_at IS NULL)
+		WITH n, f.user_id AS user_id`
+		notFound = ErrTreeNotFound
+	}
+	if add {
+		cypher += `
+		FOREACH (name IN $tags | MERGE (g:Tag {user_id: user_id, name: name}) MERGE (n)-[:tagged]->(g))`
+	} else {
+		cypher += `
+		OPTIONAL MATCH (n)-[r:tagged]->(g:Tag) WHERE g.name IN $tags
+		DELETE r`
+	}
+	cypher += `
+	WITH DISTINCT n, user_id
+	SET n.updated_at = $now
+	RETURN user_id, [(n)-[:tagged]->(g:Tag) | g.name] AS tags`
+
+	return neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) ([]string, error) {
+		result, err := tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, err
+		}
+		if !result.Next(ctx) {
+			if err := result.Err(); err != nil {
+				return nil, err
+			}
+			return nil, notFound
+		}
+		record := result.Record()
+		tagsData, _ := record.Get("tags")
+		current, err := parseTags(tagsData)
+		if err != nil {
+			return nil, err
+		}
+		if add {
+			return current, nil
+		}
+		userID, _, err := neo4j.GetRecordValue[string](record, "user_id")
+		if err != nil {
+			return nil, err
+		}
+		cypher := `MATCH (g:Tag {user_id: $user_id}) WHERE g.name IN $tags AND NOT (g)<-[:tagged]-()
+		DELETE g`
+		_, err = tx.Run(ctx, cypher, map[string]interface{}{
+			"user_id": userID,
+			"tags":    tags,
+		})
+		return current, err
+	})
+}
+
+func (s *Neo4jStore) ListTags(ctx context.Context, userID string) ([]*models.TagCount, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	// 휴지통에 없는 숲과 트리만 셈
+	cypher := `MATCH (g:Tag {user_id: $user_id})
+	OPTIONAL MATCH p = (:Forest)-[:derived*]->(t:Tree)-[:tagged]->(g) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+	WITH g, count(DISTINCT t) AS tree_count
+	OPTIONAL MATCH (f:Forest)-[:tagged]->(g) WHERE f.deleted_at IS NULL
+	WITH g, tree_count, count(DISTINCT f) AS forest_count
+	WHERE tree_count > 0 OR forest_count > 0
+	RETURN g.name AS name, tree_count, forest_count
+	ORDER BY name`
+	result, err := session.Run(ctx, cypher, map[string]interface{}{
+		"user_id": userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to run query: %w", err)
+	}
+	tags := []*models.TagCount{}
+	for result.Next(ctx) {
+		record := result.Record()
+		name, _, err := neo4j.GetRecordValue[string](record, "name")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tag record: %w", err)
+		}
+		treeCount, _, err := neo4j.GetRecordValue[int64](record, "tree_count")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tag record: %w", err)
+		}
+		forestCount, _, err := neo4j.GetRecordValue[int64](record, "forest_count")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tag record: %w", err)
+		}
+		tags = append(tags, &models.TagCount{Name: name, TreeCount: int32(treeCount), ForestCount: int32(forestCount)})
+	}
+	if err := result.Err(); err != nil {
+		return nil, fmt.Errorf("failed to run query: %w", err)
+	}
+	return tags, nil
+}
+
+func (s *Neo4jStore) FindTreesByTag(ctx context.Context, userID string, tag string) ([]*models.ForestTree, error) {
+	tag, err := normalizeTag(tag)
+	if err != nil {
+		return nil, err
+	}
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	cypher := `MATCH (:Tag {user_id: $user_id, name: $tag})<-[:tagged]-(t:Tree)
+	MATCH p = (f:Forest)-[:derived*]->(t) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+	RETURN f.id AS forest_id, ` + treeColumns("t") + `
+	ORDER BY name, id`
+	return s.readForestTrees(ctx, session, cypher, map[string]interface{}{
+		"user_id": userID,
+		"tag":     tag,
+	})
+}
+
+// canonicalUrlBackfillBatch는 BackfillCanonicalUrls가 한 번에 읽고 쓰는 트리 수입니다.
+const canonicalUrlBackfillBatch = 500
+
+// BackfillCanonicalUrls는 정규화 규칙이 Go 코드에 있으므로 canonical_url이 없는 트리를 나눠 읽어 정규화한 뒤 다시 씁니다.
+// url이 없는 트리는 ""로 채워 다음 배치에서 다시 읽지 않도록 합니다.
+func (s *Neo4jStore) BackfillCanonicalUrls(ctx context.Context, canonicalize func(string) string) (int, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	filled := 0
+	for {
+		n, err := neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (int, error) {
+			cypher := `MATCH (t:Tree) WHERE t.canonical_url IS NULL
+			RETURN t.id AS id, coalesce(t.url, "") AS url
+			LIMIT $limit`
+			result, err := tx.Run(ctx, cypher, map[string]interface{}{"limit": canonicalUrlBackfillBatch})
+			if err != nil {
+				return 0, err
+			}
+			var rows []map[string]any
+			for result.Next(ctx) {
+				id, _, err := neo4j.GetRecordValue[string](result.Record(), "id")
+				if err != nil {
+					return 0, err
+				}
+				url, _, err := neo4j.GetRecordValue[string](result.Record(), "url")
+				if err != nil {
+					return 0, err
+				}
+				rows = append(rows, map[string]any{"id": id, "canonical_url": canonicalize(url)})
+			}
+			if err := result.Err(); err != nil {
+				return 0, err
+			}
+			cypher = `UNWIND $rows AS row
+			MATCH (t:Tree {id: row.id})
+			SET t.canonical_url = row.canonical_url`
+			if _, err := tx.Run(ctx, cypher, map[string]interface{}{"rows": rows}); err != nil {
+				return 0, err
+			}
+			return len(rows), nil
+		})
+		if err != nil {
+			return filled, fmt.Errorf("failed to backfill canonical urls: %w", err)
+		}
+		filled += n
+		if n < canonicalUrlBackfillBatch {
+			return filled, nil
+		}
+	}
+}
+
+func (s *Neo4jStore) FindTreesByUrl(ctx context.Context, userID string, canonicalURL string) ([]*models.UrlMatch, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	// 두 조건 모두 인덱스를 탈 수 있도록 canonical_url과 url을 따로 비교
+	cypher := `MATCH (t:Tree) WHERE t.canonical_url = $canonical_url OR (t.url = $canonical_url AND t.canonical_url IS NULL)
+	MATCH p = (f:Forest)-[:derived*]->(t) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+	AND (f.user_id = $user_id OR EXISTS { (:User {id: $user_id})-[:member_of]->(f) })
+	WITH f, t, [n IN tail(nodes(p)) | {id: n.id, name: n.name}] AS path
+	RETURN f.id AS forest_id, f.name AS forest_name, path, ` + treeColumns("t") + `
+	ORDER BY forest_name, forest_id, size(path), id`
+	result, err := session.Run(ctx, cypher, map[string]interface{}{
+		"user_id":       userID,
+		"canonical_url": canonicalURL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to run query: %w", err)
+	}
+	matches := []*models.UrlMatch{}
+	for result.Next(ctx) {
+		record := result.Record()
+		tree, err := s.parseTreeRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tree record: %w", err)
+		}
+		forestID, _, err := neo4j.GetRecordValue[string](record, "forest_id")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tree record: %w", err)
+		}
+		forestName, _, err := neo4j.GetRecordValue[string](record, "forest_name")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tree record: %w", err)
+		}
+		pathValues, _, err := neo4j.GetRecordValue[[]any](record, "path")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tree record: %w", err)
+		}
+		path := make([]models.TreeRef, 0, len(pathValues))
+		for _, value := range pathValues {
+			node, ok := value.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("failed to parse tree record: unexpected path node %T", value)
+			}
+			id, _ := node["id"].(string)
+			name, _ := node["name"].(string)
+			path = append(path, models.TreeRef{Id: id, Name: name})
+		}
+		matches = append(matches, &models.UrlMatch{Tree: *tree, ForestId: forestID, ForestName: forestName, Path: path})
+	}
+	if err := result.Err(); err != nil {
+		return nil, fmt.Errorf("failed to run query: %w", err)
+	}
+	return matches, nil
+}
+
+// readForestTrees는 트리 필드와 forest_id를 반환하는 쿼리의 결과를 읽습니다.
+func (s *Neo4jStore) readForestTrees(ctx context.Context, session neo4j.SessionWithContext, cypher string, parameters map[string]interface{}) ([]*models.ForestTree, error) {
+	result, err := session.Run(ctx, cypher, parameters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run query: %w", err)
+	}
+	trees := []*models.ForestTree{}
+	for result.Next(ctx) {
+		record := result.Record()
+		tree, err := s.parseTreeRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tree record: %w", err)
+		}
+		forestID, _, err := neo4j.GetRecordValue[string](record, "forest_id")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tree record: %w", err)
+		}
+		trees = append(trees, &models.ForestTree{Tree: *tree, ForestId: forestID})
+	}
+	if err := result.Err(); err != nil {
+		return nil, fmt.Errorf("failed to run query: %w", err)
+	}
+	return trees, nil
+}
+
+// SearchTrees는 전문 검색 인덱스로 각 단어로 시작하는 토큰을 모두 가진 트리를 점수 순으로 찾습니다.
+// 단어 중간과 일치하는 트리는 찾지 못하며, 최종 필터링과 점수 계산은 Store.Search에서 합니다.
+func (s *Neo4jStore) SearchTrees(ctx context.Context, userID string, terms []string, opts SearchOptions, limit int) ([]*models.ForestTree, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	clauses := make([]string, len(terms))
+	for i, term := range terms {
+		clauses[i] = escapeLucene(term) + "*"
+	}
+	cypher := `CALL db.index.fulltext.queryNodes("` + treeSearchIndex + `", $query) YIELD node AS t, score
+	MATCH p = (f:Forest)-[:derived*]->(t) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+	AND ` + searchScopeFilter + `
+	RETURN f.id AS forest_id, ` + treeColumns("t") + `
+	ORDER BY score DESC
+	LIMIT $limit`
+	return s.readForestTrees(ctx, session, cypher, map[string]interface{}{
+		"query":     strings.Join(clauses, " AND "),
+		"user_id":   userID,
+		"scope":     string(opts.Scope),
+		"forest_id": opts.ForestID,
+		"limit":     limit,
+	})
+}
+
+func (s *Neo4jStore) TreesInScope(ctx context.Context, userID string, treeIDs []string, opts SearchOptions) ([]*models.ForestTree, error) {
+	if len(treeIDs) == 0 {
+		return nil, nil
+	}
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	cypher := `MATCH p = (f:Forest)-[:derived*]->(t:Tree) WHERE t.id IN $tree_ids AND all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+	AND ` + searchScopeFilter + `
+	RETURN f.id AS forest_id, ` + treeColumns("t")
+	return s.readForestTrees(ctx, session, cypher, map[string]interface{}{
+		"tree_ids":  treeIDs,
+		"user_id":   userID,
+		"scope":     string(opts.Scope),
+		"forest_id": opts.ForestID,
+	})
+}
+
+func (s *Neo4jStore) WalkForest(ctx context.Context, forestID string, visit func(node *models.TreeNode) error) error {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+	parameters := map[string]interface{}{
+		"forest_id": forestID,
+	}
+
+	cypher := `MATCH (f:Forest {id: $forest_id}) WHERE f.deleted_at IS NULL RETURN f.id AS id`
+	result, err := session.Run(ctx, cypher, parameters)
+	if err != nil {
+		return fmt.Errorf("failed to run query: %w", err)
+	}
+	if !result.Next(ctx) {
+		if err := result.Err(); err != nil {
+			return fmt.Errorf("failed to run query: %w", err)
+		}
+		return ErrForestNotFound
+	}
+
+	// 결과를 모두 받아 두지 않고 레코드를 읽는 대로 visit에 넘김
+	// 같은 깊이에서는 루트부터의 형제 순서 키 (position, name, id) 목록으로 정렬해
+	// 부모의 너비 우선 순서를 따르도록 함 (MemoryForestStore.WalkForest와 같은 순서)
+	cypher = `MATCH (f:Forest {id: $forest_id})-[:derived]->(root:Tree) WHERE root.deleted_at IS NULL
+	MATCH p = (root)-[:derived*0..]->(t:Tree)
+	WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+	WITH t, length(p) AS depth,
+		CASE WHEN length(p) = 0 THEN "" ELSE nodes(p)[-2].id END AS parent_id,
+		[i IN range(0, length(p) - 1) | [relationships(p)[i].position, nodes(p)[i + 1].name, nodes(p)[i + 1].id]] AS sibling_path
+	RETURN ` + treeColumns("t") + `, parent_id, depth
+	ORDER BY depth, sibling_path`
+	result, err = session.Run(ctx, cypher, parameters)
+	if err != nil {
+		return fmt.Errorf("failed to run query: %w", err)
+	}
+	for result.Next(ctx) {
+		record := result.Record()
+		tree, err := s.parseTreeRecord(record)
+		if err != nil {
+			return fmt.Errorf("failed to parse tree record: %w", err)
+		}
+		parentID, _, err := neo4j.GetRecordValue[string](record, "parent_id")
+		if err != nil {
+			return fmt.Errorf("failed to parse tree record: %w", err)
+		}
+		depth, _, err := neo4j.GetRecordValue[int64](record, "depth")
+		if err != nil {
+			return fmt.Errorf("failed to parse tree record: %w", err)
+		}
+		if err := visit(&models.TreeNode{Tree: *tree, ParentId: parentID, Depth: int32(depth)}); err != nil {
+			return err
+		}
+	}
+	if err := result.Err(); err != nil {
+		return fmt.Errorf("failed to run query: %w", err)
+	}
+	return nil
+}
+
+func (s *Neo4jStore) ListChildren(ctx context.Context, parentID string, pageSize int, pageToken string) ([]*models.Tree, string, error) {
+	offset, err := decodeOffsetToken(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	pageSize = childrenPageSize(pageSize)
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+	parameters := map[string]interface{}{
+		"parent_id": parentID,
+		"offset":    offset,
+		"limit":     pageSize + 1,
+	}
+
+	cypher := `MATCH p = (:Forest)-[:derived*]->(:Tree {id: $parent_id}) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+	RETURN count(p) > 0 AS found`
+	result, err := session.Run(ctx, cypher, parameters)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to run query: %w", err)
+	}
+	record, err := result.Single(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to run query: %w", err)
+	}
+	if found, _, err := neo4j.GetRecordValue[bool](record, "found"); err != nil {
+		return nil, "", err
+	} else if !found {
+		return nil, "", ErrTreeNotFound
+	}
+
+	// 다음 페이지 확인을 위해 하나 더 조회
+	cypher = `MATCH (:Tree {id: $parent_id})-[r:derived]->(child:Tree) WHERE child.deleted_at IS NULL
+	RETURN ` + treeColumns("child") + `,
+		size([(child)-[:derived]->(grandchild:Tree) WHERE grandchild.deleted_at IS NULL | grandchild]) AS child_count
+	ORDER BY r.position, child.name, child.id
+	SKIP $offset LIMIT $limit`
+	result, err = session.Run(ctx, cypher, parameters)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to run query: %w", err)
+	}
+	var children []*models.Tree
+	nextPageToken := ""
+	for result.Next(ctx) {
+		if len(children) == pageSize {
+			nextPageToken = encodeOffsetToken(offset + pageSize)
+			break
+		}
+		child, err := s.parseTreeRecord(result.Record())
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to parse tree record: %w", err)
+		}
+		childCount, _, err := neo4j.GetRecordValue[int64](result.Record(), "child_count")
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to parse tree record: %w", err)
+		}
+		child.ChildCount = int32(childCount)
+		children = append(children, child)
+	}
+	if err := result.Err(); err != nil {
+		return nil, "", fmt.Errorf("failed to run query: %w", err)
+	}
+	return children, nextPageToken, nil
+}
+
+func (s *Neo4jStore) DeleteTree(ctx context.Context, treeID string, cascade bool) ([]string, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+	now := neo4jTime(time.Now())
+	parameters := map[string]interface{}{
+		"tree_id": treeID,
+		"now":     now,
+	}
+
+	// 트리 삭제와 숲 정보 업데이트를 하나의 트랜잭션에서 처리
+	return neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) ([]string, error) {
+		// 트리가 속한 숲과 부모, 자식 조회
+		cypher := `MATCH p = (f:Forest)-[:derived*]->(t:Tree {id: $tree_id}) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+		MATCH (parent)-[:derived]->(t)
+		OPTIONAL MATCH (t)-[:derived]->(child:Tree) WHERE child.deleted_at IS NULL
+		RETURN f.id AS forestId, parent.id AS parentId, parent:Forest AS isRoot, count(child) AS childCount`
+		resp, err := tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Next(ctx) {
+			if err := resp.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrTreeNotFound
+		}
+		forestId, _, err := neo4j.GetRecordValue[string](resp.Record(), "forestId")
+		if err != nil {
+			return nil, err
+		}
+		parentId, _, err := neo4j.GetRecordValue[string](resp.Record(), "parentId")
+		if err != nil {
+			return nil, err
+		}
+		isRoot, _, err := neo4j.GetRecordValue[bool](resp.Record(), "isRoot")
+		if err != nil {
+			return nil, err
+		}
+		childCount, _, err := neo4j.GetRecordValue[int64](resp.Record(), "childCount")
+		if err != nil {
+			return nil, err
+		}
+
+		deletedId := []string{treeID}
+		if cascade {
+			// 하위 트리는 그대로 두고 트리만 휴지통으로 옮김
+			cypher = `MATCH (t:Tree {id: $tree_id})
+			OPTIONAL MATCH p = (t)-[:derived*]->(descendant:Tree) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+			WITH t, collect(descendant.id) AS descendantIds
+			SET t.deleted_at = $now
+			RETURN descendantIds`
+			resp, err = tx.Run(ctx, cypher, parameters)
+			if err != nil {
+				return nil, err
+			}
+			if !resp.Next(ctx) {
+				return nil, ErrTreeNotFound
+			}
+			descendantIds, err := getStringList(resp.Record(), "descendantIds")
+			if err != nil {
+				return nil, err
+			}
+			deletedId = append(deletedId, descendantIds...)
+		} else {
+			// 루트 트리는 숲에 하나만 연결될 수 있으므로 자식이 여럿이면 승격할 수 없음
+			if isRoot && childCount > 1 {
+				return nil, ErrRootHasMultipleChildren
+			}
+			// 자식들이 삭제되는 트리의 자리를 이어받도록 부모의 새 형제 순서 계산
+			children, _, err := childOrder(ctx, tx, treeID)
+			if err != nil {
+				return nil, err
+			}
+			var siblings []string
+			if !isRoot {
+				if siblings, _, err = childOrder(ctx, tx, parentId); err != nil {
+					return nil, err
+				}
+				siblings = replaceSibling(siblings, treeID, append(children, treeID))
+			}
+			// 자식들을 부모에 연결하고, 트리는 자식 없는 상태로 휴지통에 남김
+			// 복원할 때 다시 데려올 수 있도록 옮긴 자식들의 원래 순서를 기록
+			parameters["promoted"] = children
+			cypher = `MATCH (parent)-[:derived]->(target:Tree {id: $tree_id})
+			OPTIONAL MATCH (target)-[r:derived]->(child:Tree)
+			// 자식이 존재할 때만 부모와 새로운 관계 연결
+			FOREACH (_ IN CASE WHEN child IS NOT NULL THEN [1] ELSE [] END |
+				CREATE (parent)-[:derived]->(child)
+				DELETE r
+			)
+			WITH DISTINCT target
+			SET target.deleted_at = $now, target.promoted_children = $promoted`
+			if _, err := tx.Run(ctx, cypher, parameters); err != nil {
+				return nil, err
+			}
+			if !isRoot {
+				if err := setChildOrder(ctx, tx, parentId, siblings); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		// 숲 정보 업데이트 (남은 트리가 없으면 depth는 0)
+		if err := recountForests(ctx, tx, now, forestId); err != nil {
+			return nil, err
+		}
+		return deletedId, nil
+	})
+}
+
+func (s *Neo4jStore) MoveTree(ctx context.Context, treeID string, newParentID string) (*models.Tree, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+	parameters := map[string]interface{}{
+		"tree_id":       treeID,
+		"new_parent_id": newParentID,
+	}
+
+	return neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (*models.Tree, error) {
+		// 이동할 트리와 새 부모가 속한 숲, 순환 여부 조회
+		cypher := `MATCH p = (src:Forest)-[:derived*]->(t:Tree {id: $tree_id}) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+		MATCH (oldParent)-[:derived]->(t)
+		MATCH q = (dst:Forest)-[:derived*]->(np:Tree {id: $new_parent_id}) WHERE all(n IN nodes(q) WHERE n.deleted_at IS NULL)
+		RETURN src.id AS srcId, dst.id AS dstId, oldParent:Forest AS isRoot, EXISTS { (t)-[:derived*0..]->(np) } AS isCycle`
+		resp, err := tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Next(ctx) {
+			if err := resp.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrTreeNotFound
+		}
+		record := resp.Record()
+		srcId, _, err := neo4j.GetRecordValue[string](record, "srcId")
+		if err != nil {
+			return nil, err
+		}
+		dstId, _, err := neo4j.GetRecordValue[string](record, "dstId")
+		if err != nil {
+			return nil, err
+		}
+		if isRoot, _, err := neo4j.GetRecordValue[bool](record, "isRoot"); err != nil {
+			return nil, err
+		} else if isRoot {
+			return nil, ErrMoveRootTree
+		}
+		if isCycle, _, err := neo4j.GetRecordValue[bool](record, "isCycle"); err != nil {
+			return nil, err
+		} else if isCycle {
+			return nil, ErrMoveIntoDescendant
+		}
+
+		cypher = `MATCH (:Tree)-[r:derived]->(t:Tree {id: $tree_id})
+		MATCH (np:Tree {id: $new_parent_id})
+		DELETE r
+		CREATE (np)-[:derived]->(t)
+		RETURN ` + treeColumns("t")
+		resp, err = tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Next(ctx) {
+			return nil, ErrTreeNotFound
+		}
+		moved, err := s.parseTreeRecord(resp.Record())
+		if err != nil {
+			return nil, err
+		}
+		// 옮긴 트리는 새 부모의 마지막 자식이 됨
+		if err := renumberChildren(ctx, tx, newParentID); err != nil {
+			return nil, err
+		}
+
+		if err := recountForests(ctx, tx, neo4jTime(time.Now()), srcId, dstId); err != nil {
+			return nil, err
+		}
+		return moved, nil
+	})
+}
+
+func (s *Neo4jStore) ReorderChildren(ctx context.Context, parentID string, childIDs []string) (*models.Tree, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	return neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (*models.Tree, error) {
+		cypher := `MATCH p = (:Forest)-[:derived*]->(t:Tree {id: $parent_id}) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+		RETURN ` + treeColumns("t")
+		resp, err := tx.Run(ctx, cypher, map[string]interface{}{"parent_id": parentID})
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Next(ctx) {
+			if err := resp.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrTreeNotFound
+		}
+		parent, err := s.parseTreeRecord(resp.Record())
+		if err != nil {
+			return nil, err
+		}
+
+		children, liveChildren, err := childOrder(ctx, tx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		ordered, err := reorderChildren(children, liveChildren, childIDs)
+		if err != nil {
+			return nil, err
+		}
+		if err := setChildOrder(ctx, tx, parentID, ordered); err != nil {
+			return nil, err
+		}
+		if err := s.getDerived(ctx, tx, 0, parent); err != nil {
+			return nil, err
+		}
+		return parent, nil
+	})
+}
+
+func (s *Neo4jStore) SplitForest(ctx context.Context, treeID string, forest *models.Forest) (*models.Forest, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+	forestID := uuid.New().String()
+	now := neo4jTime(time.Now())
+
+	return neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (*models.Forest, error) {
+		cypher := `MATCH p = (src:Forest)-[:derived*]->(t:Tree {id: $tree_id}) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+		MATCH (parent)-[:derived]->(t)
+		RETURN src.id AS srcId, src.user_id AS userId, parent:Forest AS isRoot`
+		resp, err := tx.Run(ctx, cypher, map[string]interface{}{"tree_id": treeID})
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Next(ctx) {
+			if err := resp.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrTreeNotFound
+		}
+		record := resp.Record()
+		srcId, _, err := neo4j.GetRecordValue[string](record, "srcId")
+		if err != nil {
+			return nil, err
+		}
+		userId, _, err := neo4j.GetRecordValue[string](record, "userId")
+		if err != nil {
+			return nil, err
+		}
+		if isRoot, _, err := neo4j.GetRecordValue[bool](record, "isRoot"); err != nil {
+			return nil, err
+		} else if isRoot {
+			return nil, ErrMoveRootTree
+		}
+
+		// 기존 부모와의 관계를 끊고 새 숲의 루트로 연결
+		cypher = `MATCH (:Tree)-[r:derived]->(t:Tree {id: $tree_id})
+		DELETE r
+		CREATE (f:Forest {id: $id, name: $name, description: $description, depth: 0, total_trees: 0, user_id: $user_id,
+			created_at: $now, updated_at: $now})-[:derived]->(t)`
+		_, err = tx.Run(ctx, cypher, map[string]interface{}{
+			"tree_id":     treeID,
+			"id":          forestID,
+			"name":        forest.Name,
+			"description": forest.Description,
+			"user_id":     userId,
+			"now":         now,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := recountForests(ctx, tx, now, srcId, forestID); err != nil {
+			return nil, err
+		}
+		return s.readForest(ctx, tx, forestID)
+	})
+}
+
+func (s *Neo4jStore) GraftForest(ctx context.Context, forestID string, targetTreeID string) (*models.Forest, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+	parameters := map[string]interface{}{
+		"forest_id":      forestID,
+		"target_tree_id": targetTreeID,
+	}
+
+	return neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (*models.Forest, error) {
+		cypher := `MATCH (f:Forest {id: $forest_id})-[:derived]->(root:Tree) WHERE f.deleted_at IS NULL AND root.deleted_at IS NULL
+		RETURN f.user_id AS userId`
+		resp, err := tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Next(ctx) {
+			if err := resp.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrForestNotFound
+		}
+		srcUser, _, err := neo4j.GetRecordValue[string](resp.Record(), "userId")
+		if err != nil {
+			return nil, err
+		}
+
+		cypher = `MATCH p = (dst:Forest)-[:derived*]->(:Tree {id: $target_tree_id}) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+		RETURN dst.id AS dstId, dst.user_id AS userId`
+		resp, err = tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Next(ctx) {
+			if err := resp.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrTreeNotFound
+		}
+		dstId, _, err := neo4j.GetRecordValue[string](resp.Record(), "dstId")
+		if err != nil {
+			return nil, err
+		}
+		dstUser, _, err := neo4j.GetRecordValue[string](resp.Record(), "userId")
+		if err != nil {
+			return nil, err
+		}
+		if dstId == forestID {
+			return nil, ErrMoveIntoDescendant
+		}
+		if dstUser != srcUser {
+			return nil, ErrForestOwnerMismatch
+		}
+
+		// 루트 트리(휴지통에 있는 이전 루트 포함)를 대상 트리 아래로 옮기고 숲 노드 삭제
+		// PurgeForest와 같이 숲의 공개 링크도 지우며, 멤버 관계는 DETACH DELETE로 함께 지워짐
+		cypher = `MATCH (f:Forest {id: $forest_id})-[r:derived]->(root:Tree)
+		MATCH (target:Tree {id: $target_tree_id})
+		DELETE r
+		CREATE (target)-[:derived]->(root)
+		WITH DISTINCT f
+		OPTIONAL MATCH (l:ShareLink)-[:shares]->(f)
+		DETACH DELETE f, l`
+		if _, err := tx.Run(ctx, cypher, parameters); err != nil {
+			return nil, err
+		}
+		if err := renumberChildren(ctx, tx, targetTreeID); err != nil {
+			return nil, err
+		}
+
+		if err := recountForests(ctx, tx, neo4jTime(time.Now()), dstId); err != nil {
+			return nil, err
+		}
+		return s.readForest(ctx, tx, dstId)
+	})
+}
+
+func (s *Neo4jStore) CopyTree(ctx context.Context, treeID string, targetParentID string) (*models.Tree, map[string]string, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+	idMap := map[string]string{}
+
+	copied, err := neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (*models.Tree, error) {
+		clear(idMap) // 재시도 시 이전 시도의 매핑 제거
+		cypher := `MATCH p = (:Forest)-[:derived*]->(t:Tree {id: $tree_id}) WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+		MATCH q = (dst:Forest)-[:derived*]->(:Tree {id: $target_parent_id}) WHERE all(n IN nodes(q) WHERE n.deleted_at IS NULL)
+		RETURN ` + treeColumns("t") + `, dst.id AS dstId,
+			dst.user_id AS userId`
+		resp, err := tx.Run(ctx, cypher, map[string]interface{}{
+			"tree_id":          treeID,
+			"target_parent_id": targetParentID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Next(ctx) {
+			if err := resp.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrTreeNotFound
+		}
+		source, err := s.parseTreeRecord(resp.Record())
+		if err != nil {
+			return nil, err
+		}
+		dstId, _, err := neo4j.GetRecordValue[string](resp.Record(), "dstId")
+		if err != nil {
+			return nil, err
+		}
+		userId, _, err := neo4j.GetRecordValue[string](resp.Record(), "userId")
+		if err != nil {
+			return nil, err
+		}
+		// 복사 대상이 원본의 하위 트리여도 되도록 복사 전에 원본 구조를 먼저 읽음
+		if err := s.getDerived(ctx, tx, 0, source); err != nil {
+			return nil, err
+		}
+
+		now := neo4jTime(time.Now())
+		copied := cloneTree(source, idMap, now)
+		if err := createSubtree(ctx, tx, userId, copied); err != nil {
+			return nil, err
+		}
+		cypher = `MATCH (parent:Tree {id: $parent_id})
+		MATCH (child:Tree {id: $child_id})
+		CREATE (parent)-[:derived]->(child)`
+		_, err = tx.Run(ctx, cypher, map[string]interface{}{
+			"parent_id": targetParentID,
+			"child_id":  copied.Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := renumberChildren(ctx, tx, targetParentID); err != nil {
+			return nil, err
+		}
+		if err := recountForests(ctx, tx, now, dstId); err != nil {
+			return nil, err
+		}
+		return copied, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return copied, idMap, nil
+}
+
+func (s *Neo4jStore) ImportForest(ctx context.Context, forest *models.Forest, visits []*models.Visit) error {
+	if forest == nil || forest.Root == nil {
+		return errors.New("invalid forest data")
+	}
+	if err := checkImportVisits(forest.Root, visits); err != nil {
+		return err
+	}
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	forest.Id = uuid.New().String()
+	forest.CreatedAt = neo4jTime(time.Now())
+	forest.UpdatedAt = forest.CreatedAt
+	forest.TotalTrees, forest.Depth = stampTree(forest.Root, forest.CreatedAt)
+	visitValues := make([]map[string]any, len(visits))
+	for i, visit := range visits {
+		visit.Id = uuid.New().String()
+		visitValues[i] = map[string]any{
+			"id":         visit.Id,
+			"tree_id":    visit.TreeId,
+			"user_id":    visit.UserId,
+			"visited_at": neo4jTime(visit.VisitedAt),
+			"dwell_ms":   visit.DwellMs,
+		}
+	}
+
+	_, err := neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (any, error) {
+		if err := createSubtree(ctx, tx, forest.UserId, forest.Root); err != nil {
+			return nil, err
+		}
+		cypher := `MATCH (t:Tree {id: $tree_id})
+		CREATE (f:Forest {id: $id, name: $name, description: $description, depth: $depth, total_trees: $total_trees, user_id: $user_id,
+			created_at: $created_at, updated_at: $created_at})-[:derived]->(t)`
+		_, err := tx.Run(ctx, cypher, map[string]interface{}{
+			"tree_id":     forest.Root.Id,
+			"id":          forest.Id,
+			"name":        forest.Name,
+			"description": forest.Description,
+			"depth":       forest.Depth,
+			"total_trees": forest.TotalTrees,
+			"user_id":     forest.UserId,
+			"created_at":  neo4jTime(forest.CreatedAt),
+		})
+		if err != nil {
+			return nil, err
+		}
+		// 새로 만든 트리라 이전 방문이 없으므로 방문 집계를 바로 계산해 저장
+		cypher = `UNWIND $visits AS v
+		MATCH (t:Tree {id: v.tree_id})
+		CREATE (t)-[:visited]->(:Visit {id: v.id, user_id: v.user_id, visited_at: v.visited_at, dwell_ms: v.dwell_ms})
+		WITH t, min(v.visited_at) AS first_visited_at, max(v.visited_at) AS last_visited_at, count(v) AS visit_count, sum(v.dwell_ms) AS total_dwell_ms
+		SET t.first_visited_at = first_visited_at, t.last_visited_at = last_visited_at, t.visit_count = visit_count, t.total_dwell_ms = total_dwell_ms`
+		_, err = tx.Run(ctx, cypher, map[string]interface{}{"visits": visitValues})
+		return nil, err
+	})
+	return err
+}
+
+func (s *Neo4jStore) CloneForest(ctx context.Context, forestID string, name string) (*models.Forest, map[string]string, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+	idMap := map[string]string{}
+
+	cloned, err := neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (*models.Forest, error) {
+		clear(idMap) // 재시도 시 이전 시도의 매핑 제거
+		source, err := s.readForest(ctx, tx, forestID)
+		if err != nil {
+			return nil, err
+		}
+		if source.Root == nil {
+			return nil, ErrTreeNotFound
+		}
+		if err := s.getDerived(ctx, tx, 0, source.Root); err != nil {
+			return nil, err
+		}
+
+		cloned := *source
+		cloned.Id = uuid.New().String()
+		cloned.CreatedAt = neo4jTime(time.Now())
+		cloned.UpdatedAt = cloned.CreatedAt
+		if name != "" {
+			cloned.Name = name
+		}
+		cloned.Root = cloneTree(source.Root, idMap, cloned.CreatedAt)
+		if err := createSubtree(ctx, tx, cloned.UserId, cloned.Root); err != nil {
+			return nil, err
+		}
+		cypher := `MATCH (t:Tree {id: $tree_id})
+		CREATE (f:Forest {id: $id, name: $name, description: $description, depth: $depth, total_trees: $total_trees, user_id: $user_id,
+			created_at: $created_at, updated_at: $created_at})-[:derived]->(t)`
+		_, err = tx.Run(ctx, cypher, map[string]interface{}{
+			"tree_id":     cloned.Root.Id,
+			"id":          cloned.Id,
+			"name":        cloned.Name,
+			"description": cloned.Description,
+			"depth":       cloned.Depth,
+			"total_trees": cloned.TotalTrees,
+			"user_id":     cloned.UserId,
+			"created_at":  neo4jTime(cloned.CreatedAt),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := tagNodes(ctx, tx, "Forest", cloned.UserId, map[string][]string{cloned.Id: cloned.Tags}); err != nil {
+			return nil, err
+		}
+		return &cloned, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return cloned, idMap, nil
+}
+
+func (s *Neo4jStore) ListTrash(ctx context.Context, userID string) ([]*models.TrashItem, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	// 휴지통에 있는 숲과, 부모가 휴지통에 없는 트리만 조회 (휴지통에 있는 트리의 하위 트리는 함께 복원되므로 제외)
+	cypher := `MATCH (f:Forest) WHERE f.deleted_at IS NOT NULL AND ($user_id = "" OR f.user_id = $user_id)
+	RETURN "forest" AS type, f.id AS id, f.name AS name, f.id AS forest_id, f.user_id AS user_id, f.deleted_at AS deleted_at
+	UNION ALL
+	MATCH p = (f:Forest)-[:derived*]->(t:Tree)
+	WHERE t.deleted_at IS NOT NULL AND ($user_id = "" OR f.user_id = $user_id) AND all(n IN nodes(p)[..-1] WHERE n.deleted_at IS NULL)
+	RETURN "tree" AS type, t.id AS id, t.name AS name, f.id AS forest_id, f.user_id AS user_id, t.deleted_at AS deleted_at`
+	result, err := session.Run(ctx, cypher, map[string]interface{}{
+		"user_id": userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to run query: %w", err)
+	}
+
+	var items []*models.TrashItem
+	for result.Next(ctx) {
+		item, err := parseTrashItemRecord(result.Record())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse trash record: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := result.Err(); err != nil {
+		return nil, fmt.Errorf("failed to run query: %w", err)
+	}
+	sortTrashItems(items)
+	return items, nil
+}
+
+func (s *Neo4jStore) RestoreForest(ctx context.Context, forestID string) (*models.Forest, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+
+	return neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (*models.Forest, error) {
+		cypher := `MATCH (f:Forest {id: $forest_id}) RETURN f.deleted_at IS NOT NULL AS deleted`
+		resp, err := tx.Run(ctx, cypher, map[string]interface{}{"forest_id": forestID})
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Next(ctx) {
+			if err := resp.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrForestNotFound
+		}
+		if deleted, _, err := neo4j.GetRecordValue[bool](resp.Record(), "deleted"); err != nil {
+			return nil, err
+		} else if !deleted {
+			return nil, ErrNotInTrash
+		}
+
+		cypher = `MATCH (f:Forest {id: $forest_id}) REMOVE f.deleted_at`
+		if _, err := tx.Run(ctx, cypher, map[string]interface{}{"forest_id": forestID}); err != nil {
+			return nil, err
+		}
+		return s.readForest(ctx, tx, forestID)
+	})
+}
+
+func (s *Neo4jStore) RestoreTree(ctx context.Context, treeID string) (*models.Tree, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+	parameters := map[string]interface{}{
+		"tree_id": treeID,
+	}
+
+	return neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (*models.Tree, error) {
+		// 부모까지의 경로가 모두 휴지통 밖에 있어야 복원 가능
+		// 삭제될 때 부모로 옮겨진 자식 중 아직 같은 부모 아래에 있는 자식은 다시 데려옴
+		// 이전 루트 트리는 숲에 다시 데려올 자식 말고 다른 루트 트리가 없을 때만 복원 가능
+		cypher := `MATCH p = (f:Forest)-[:derived*]->(t:Tree {id: $tree_id})
+		MATCH (parent)-[:derived]->(t)
+		WITH f, t, parent, p, coalesce(t.promoted_children, []) AS promoted
+		WITH f, t, parent, p, promoted, [(parent)-[:derived]->(c:Tree) WHERE c.id IN promoted | c.id] AS reattachable
+		RETURN f.id AS forestId, parent.id AS parentId, parent:Forest AS isRoot, t.deleted_at IS NOT NULL AS deleted,
+			promoted, reattachable,
+			all(n IN nodes(p)[..-1] WHERE n.deleted_at IS NULL) AS parentLive,
+			parent:Forest AND EXISTS { (f)-[:derived]->(r:Tree) WHERE r.deleted_at IS NULL AND r.id <> t.id AND NOT r.id IN reattachable } AS hasRoot`
+		resp, err := tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Next(ctx) {
+			if err := resp.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrTreeNotFound
+		}
+		record := resp.Record()
+		forestId, _, err := neo4j.GetRecordValue[string](record, "forestId")
+		if err != nil {
+			return nil, err
+		}
+		if deleted, _, err := neo4j.GetRecordValue[bool](record, "deleted"); err != nil {
+			return nil, err
+		} else if !deleted {
+			return nil, ErrNotInTrash
+		}
+		parentLive, _, err := neo4j.GetRecordValue[bool](record, "parentLive")
+		if err != nil {
+			return nil, err
+		}
+		hasRoot, _, err := neo4j.GetRecordValue[bool](record, "hasRoot")
+		if err != nil {
+			return nil, err
+		}
+		if !parentLive || hasRoot {
+			return nil, ErrRestoreBlocked
+		}
+		parentId, _, err := neo4j.GetRecordValue[string](record, "parentId")
+		if err != nil {
+			return nil, err
+		}
+		isRoot, _, err := neo4j.GetRecordValue[bool](record, "isRoot")
+		if err != nil {
+			return nil, err
+		}
+		promoted, err := getStringList(record, "promoted")
+		if err != nil {
+			return nil, err
+		}
+		reattachable, err := getStringList(record, "reattachable")
+		if err != nil {
+			return nil, err
+		}
+		// 원래 자식 순서대로 다시 데려옴
+		reattach := []string{}
+		for _, childID := range promoted {
+			if slices.Contains(reattachable, childID) {
+				reattach = append(reattach, childID)
+			}
+		}
+
+		// 트리가 자식들의 자리를 되찾도록 부모의 새 형제 순서 계산
+		var siblings []string
+		if !isRoot {
+			if siblings, _, err = childOrder(ctx, tx, parentId); err != nil {
+				return nil, err
+			}
+			siblings = collapseSiblings(siblings, treeID, reattach)
+		}
+		parameters["reattach"] = reattach
+		cypher = `MATCH (parent)-[:derived]->(t:Tree {id: $tree_id})
+		MATCH (parent)-[r:derived]->(child:Tree) WHERE child.id IN $reattach
+		CREATE (t)-[:derived]->(child)
+		DELETE r`
+		if _, err := tx.Run(ctx, cypher, parameters); err != nil {
+			return nil, err
+		}
+		if err := setChildOrder(ctx, tx, treeID, reattach); err != nil {
+			return nil, err
+		}
+		if !isRoot {
+			if err := setChildOrder(ctx, tx, parentId, siblings); err != nil {
+				return nil, err
+			}
+		}
+
+		cypher = `MATCH (t:Tree {id: $tree_id})
+		REMOVE t.deleted_at, t.promoted_children
+		RETURN ` + treeColumns("t")
+		resp, err = tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Next(ctx) {
+			return nil, ErrTreeNotFound
+		}
+		restored, err := s.parseTreeRecord(resp.Record())
+		if err != nil {
+			return nil, err
+		}
+		if err := recountForests(ctx, tx, neo4jTime(time.Now()), forestId); err != nil {
+			return nil, err
+		}
+		return restored, nil
+	})
+}
+
+func (s *Neo4jStore) PurgeForest(ctx context.Context, forestID string) ([]string, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+	parameters := map[string]interface{}{
+		"forest_id": forestID,
+	}
+
+	return neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) ([]string, error) {
+		cypher := `MATCH (f:Forest {id: $forest_id}) OPTIONAL MATCH (f)-[:derived*]->(t:Tree) RETURN collect(t.id) AS ids`
+		result, err := tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, err
+		}
+		if !result.Next(ctx) {
+			if err := result.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrForestNotFound
+		}
+		idsToDelete, err := getStringList(result.Record(), "ids")
+		if err != nil {
+			return nil, err
+		}
+
+		cypher = `MATCH (n:Forest {id: $forest_id})
+		OPTIONAL MATCH (n)-[:derived*]->(d:Tree)
+		OPTIONAL MATCH (d)-[:visited]->(v:Visit)
+		OPTIONAL MATCH (l:ShareLink)-[:shares]->(n)
+		DETACH DELETE n, d, v, l`
+		if _, err := tx.Run(ctx, cypher, parameters); err != nil {
+			return nil, err
+		}
+		return idsToDelete, nil
+	})
+}
+
+func (s *Neo4jStore) PurgeTree(ctx context.Context, treeID string) ([]string, error) {
+	session := s.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+	parameters := map[string]interface{}{
+		"tree_id": treeID,
+	}
+
+	return neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) ([]string, error) {
+		cypher := `MATCH (f:Forest)-[:derived*]->(t:Tree {id: $tree_id})
+		MATCH (t)-[:derived*0..]->(d:Tree)
+		RETURN f.id AS forestId, collect(d.id) AS ids`
+		resp, err := tx.Run(ctx, cypher, parameters)
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Next(ctx) {
+			if err := resp.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrTreeNotFound
+		}
+		forestId, _, err := neo4j.GetRecordValue[string](resp.Record(), "forestId")
+		if err != nil {
+			return nil, err
+		}
+		idsToDelete, err := getStringList(resp.Record(), "ids")
+		if err != nil {
+			return nil, err
+		}
+
+		cypher = `MATCH (t:Tree {id: $tree_id})-[:derived*0..]->(d:Tree)
+		OPTIONAL MATCH (d)-[:visited]->(v:Visit)
+		DETACH DELETE d, v`
+		if _, err := tx.Run(ctx, cypher, parameters); err != nil {
+			return nil, err
+		}
+		if err := recountForests(ctx, tx, neo4jTime(time.Now()), forestId); err != nil {
+			return nil, err
+		}
+		return idsToDelete, nil
+	})
 }
@@ -0,0 +1,175 @@
+package store
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jdk829355/InForest_back/config"
+	"github.com/jdk829355/InForest_back/models"
+)
+
+// memoTimestampsMigration은 memo 테이블에 created_at, updated_at 컬럼을 추가하는 SQL입니다.
+// Supabase 테이블에는 같은 파일을 직접 실행해야 합니다.
+//
+//go:embed migrations/001_memo_timestamps.sql
+var memoTimestampsMigration string
+
+// PostgresMemoStore는 Supabase REST API를 거치지 않고 PostgreSQL에 직접 SQL을 실행하는 MemoStore 구현체입니다.
+// Supabase와 같은 memo 테이블을 사용합니다.
+type PostgresMemoStore struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresMemoStore(pool *pgxpool.Pool) (*PostgresMemoStore, error) {
+	return &PostgresMemoStore{
+		pool: pool,
+	}, nil
+}
+
+func InitPostgresMemoStore(cfg *config.Config) (*pgxpool.Pool, error) {
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, cfg.POSTGRES_URL)
+	if err != nil {
+		return nil, err
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("postgres connectivity verification failed: %w", err)
+	}
+	// 로컬 환경에서 바로 사용할 수 있도록 테이블이 없으면 생성
+	_, err = pool.Exec(ctx, `CREATE TABLE IF NOT EXISTS memo (
+		user_id TEXT NOT NULL,
+		tree_id TEXT NOT NULL,
+		content TEXT NOT NULL DEFAULT '',
+		version INTEGER NOT NULL DEFAULT 0,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		PRIMARY KEY (user_id, tree_id)
+	)`)
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+	// 시각 컬럼이 없던 이전 테이블은 컬럼을 추가하고 기존 행은 현재 시각으로 채움
+	_, err = pool.Exec(ctx, memoTimestampsMigration)
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+	return pool, nil
+}
+
+func (s *PostgresMemoStore) Close(ctx context.Context) error {
+	s.pool.Close()
+	return nil
+}
+
+func (s *PostgresMemoStore) CreateMemo(ctx context.Context, userID string, treeID string, options map[string]interface{}) (*models.Memo, error) {
+	memo := newMemoFromOptions(userID, treeID, options)
+	_, err := s.pool.Exec(ctx,
+		`INSERT INTO memo (user_id, tree_id, content, version, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)`,
+		memo.UserID, memo.TreeID, memo.Content, memo.Version, memo.CreatedAt, memo.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return memo, nil
+}
+
+func (s *PostgresMemoStore) GetMemo(ctx context.Context, userID string, treeID string) (*models.Memo, error) {
+	row := s.pool.QueryRow(ctx,
+		`SELECT `+memoColumns+` FROM memo WHERE user_id = $1 AND tree_id = $2`,
+		userID, treeID)
+	return scanMemo(row)
+}
+
+func (s *PostgresMemoStore) GetMemos(ctx context.Context, userID string, treeIDs []string) ([]*models.Memo, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT `+memoColumns+` FROM memo WHERE user_id = $1 AND tree_id = ANY($2)`,
+		userID, treeIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var memos []*models.Memo
+	for rows.Next() {
+		memo, err := scanMemo(rows)
+		if err != nil {
+			return nil, err
+		}
+		memos = append(memos, memo)
+	}
+	return memos, rows.Err()
+}
+
+// UpdateMemo는 버전 비교와 갱신을 하나의 조건부 UPDATE로 처리하므로
+// 같은 버전으로 동시에 들어온 요청 중 하나만 성공합니다.
+func (s *PostgresMemoStore) UpdateMemo(ctx context.Context, userID string, treeID string, content string, expectedVersion int32) (*models.Memo, error) {
+	row := s.pool.QueryRow(ctx,
+		`UPDATE memo SET content = $3, version = version + 1, updated_at = $5
+		WHERE user_id = $1 AND tree_id = $2 AND version = $4
+		RETURNING `+memoColumns,
+		userID, treeID, content, expectedVersion, time.Now().UTC())
+	memo, err := scanMemo(row)
+	if errors.Is(err, ErrMemoNotFound) {
+		// 갱신된 행이 없으면 메모가 없거나 버전이 다른 경우
+		if _, err := s.GetMemo(ctx, userID, treeID); err != nil {
+			return nil, err
+		}
+		return nil, ErrMemoVersionConflict
+	}
+	return memo, err
+}
+
+func (s *PostgresMemoStore) DeleteMemo(ctx context.Context, userID string, treeID string) (*models.Memo, error) {
+	row := s.pool.QueryRow(ctx,
+		`DELETE FROM memo WHERE user_id = $1 AND tree_id = $2
+		RETURNING `+memoColumns,
+		userID, treeID)
+	return scanMemo(row)
+}
+
+// SearchMemos는 단어마다 ILIKE 조건을 걸어 모든 단어를 포함하는 메모를 찾습니다.
+func (s *PostgresMemoStore) SearchMemos(ctx context.Context, userIDs []string, terms []string, offset int, limit int) ([]*models.Memo, error) {
+	query := `SELECT ` + memoColumns + ` FROM memo WHERE user_id = ANY($1)`
+	args := []any{userIDs}
+	for _, term := range terms {
+		args = append(args, "%"+escapeLike(term)+"%")
+		query += fmt.Sprintf(` AND content ILIKE $%d ESCAPE '\'`, len(args))
+	}
+	args = append(args, limit, offset)
+	query += fmt.Sprintf(` ORDER BY user_id, tree_id LIMIT $%d OFFSET $%d`, len(args)-1, len(args))
+
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var memos []*models.Memo
+	for rows.Next() {
+		memo, err := scanMemo(rows)
+		if err != nil {
+			return nil, err
+		}
+		memos = append(memos, memo)
+	}
+	return memos, rows.Err()
+}
+
+// memoColumns는 scanMemo가 읽는 컬럼 순서입니다.
+const memoColumns = `user_id, tree_id, content, version, created_at, updated_at`
+
+func scanMemo(row pgx.Row) (*models.Memo, error) {
+	memo := &models.Memo{}
+	if err := row.Scan(&memo.UserID, &memo.TreeID, &memo.Content, &memo.Version, &memo.CreatedAt, &memo.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMemoNotFound
+		}
+		return nil, err
+	}
+	return memo, nil
+}
@@ -2,25 +2,406 @@ package store
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
 
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
-	"github.com/supabase-community/supabase-go"
+	"github.com/jdk829355/InForest_back/models"
+)
+
+var (
+	// ErrForestNotFound is returned when the requested forest does not exist.
+	ErrForestNotFound = errors.New("forest not found")
+	// ErrTreeNotFound is returned when the requested tree does not exist.
+	ErrTreeNotFound = errors.New("tree not found")
+	// ErrRootHasMultipleChildren is returned when a root tree with several
+	// children is deleted without cascade, since a forest has a single root.
+	ErrRootHasMultipleChildren = errors.New("cannot delete root tree with multiple children without cascade")
+	// ErrMoveIntoDescendant is returned when a tree would be moved under itself
+	// or one of its descendants.
+	ErrMoveIntoDescendant = errors.New("cannot move a tree under itself or its descendants")
+	// ErrMoveRootTree is returned when the root tree of a forest is moved.
+	ErrMoveRootTree = errors.New("cannot move the root tree of a forest")
+	// ErrForestOwnerMismatch is returned when an operation spans forests owned
+	// by different users.
+	ErrForestOwnerMismatch = errors.New("forests belong to different users")
+	// ErrInvalidChildOrder is returned when a reorder request does not list
+	// every child of the parent exactly once.
+	ErrInvalidChildOrder = errors.New("ordered child ids must list every child of the parent exactly once")
+	// ErrInvalidPageToken is returned when a page token cannot be decoded or
+	// was issued for a different sort order.
+	ErrInvalidPageToken = errors.New("invalid page token")
+	// ErrInvalidUpdate is returned when an update has no id or names a field
+	// that cannot be updated.
+	ErrInvalidUpdate = errors.New("invalid update")
+	// ErrInvalidShare is returned when a forest is shared with its owner or
+	// with a role other than viewer or editor.
+	ErrInvalidShare = errors.New("forest can only be shared with another user as viewer or editor")
+	// ErrMemberNotFound is returned when the user is not a member of the forest.
+	ErrMemberNotFound = errors.New("user is not a member of the forest")
+	// ErrShareLinkNotFound is returned when a share link does not exist, was
+	// revoked or has expired.
+	ErrShareLinkNotFound = errors.New("share link not found or expired")
+	// ErrInvalidShareLink is returned when a share link would expire in the past.
+	ErrInvalidShareLink = errors.New("share link must expire in the future")
+	// ErrInvalidTag is returned when no tags are given or a tag is empty or
+	// longer than maxTagLength characters.
+	ErrInvalidTag = errors.New("tags must be non-empty and at most 64 characters")
+	// ErrInvalidVisit is returned when a visit has a negative dwell time.
+	ErrInvalidVisit = errors.New("visit dwell time must not be negative")
+	// ErrInvalidQuery is returned when a search query has no words or the
+	// search scope is unknown.
+	ErrInvalidQuery = errors.New("invalid search query")
+	// ErrNotInTrash is returned when restoring an item that is not in the trash.
+	ErrNotInTrash = errors.New("item is not in trash")
+	// ErrRestoreBlocked is returned when a tree cannot be restored because its
+	// parent is gone or its forest already has a root tree.
+	ErrRestoreBlocked = errors.New("cannot restore: parent is in trash or forest already has a root tree")
+	// ErrMemoNotFound is returned when the requested memo does not exist.
+	ErrMemoNotFound = errors.New("memo does not exist")
+	// ErrMemoVersionConflict is returned when a memo update's expected version
+	// does not match the stored version.
+	ErrMemoVersionConflict = errors.New("conflict: other version exists")
+)
+
+// ForestRepository는 숲과 트리 저장소가 제공해야 하는 동작을 정의합니다.
+// Neo4jStore와 MemoryForestStore가 이를 구현합니다.
+// 자식 트리는 항상 저장된 형제 순서대로 반환됩니다.
+// 삭제는 휴지통으로 옮기는 soft delete이며, 휴지통에 있는 항목은 모든 조회에서 제외됩니다.
+type ForestRepository interface {
+	// GetForestByUser는 opts 조건에 맞는 숲을 한 페이지 반환합니다. 다음 페이지가 없으면 nextPageToken은 ""입니다.
+	GetForestByUser(ctx context.Context, userID string, opts ForestListOptions) (forests []*models.Forest, nextPageToken string, err error)
+	CreateForest(ctx context.Context, forest *models.Forest, root *models.Tree) error
+	// CreateTree는 index번째 자식 위치에 트리를 추가합니다. index가 음수이거나 범위를 벗어나면 맨 뒤에 추가합니다.
+	CreateTree(ctx context.Context, tree *models.Tree, parentID string, index int) (string, error)
+	// GetForest와 GetTreeByID의 maxDepth는 includeChildren일 때 포함할 하위 트리 깊이이며, 0 이하면 제한하지 않습니다.
+	GetForest(ctx context.Context, forestID string, includeChildren bool, maxDepth int) (*models.Forest, error)
+	// UpdateForest와 UpdateTree는 fields에 있는 필드만 빈 값을 포함해 그대로 저장합니다.
+	// 업데이트할 수 없는 필드가 있으면 ErrInvalidUpdate를 반환합니다.
+	UpdateForest(ctx context.Context, forest *models.Forest, fields []string) (models.Forest, error)
+	DeleteForest(ctx context.Context, forestID string) ([]string, error)
+	// UpdateTree는 url을 바꿀 때 tree.CanonicalUrl도 함께 저장합니다.
+	UpdateTree(ctx context.Context, tree *models.Tree, fields []string) (models.Tree, error)
+	GetTreeByID(ctx context.Context, treeID string, includeChildren bool, maxDepth int) (*models.Tree, error)
+	// RecordVisit는 방문 기록을 저장하고 트리의 첫/마지막 방문 시각, 방문 횟수, 머문 시간 합계를 갱신한 트리를 반환합니다.
+	// 방문 기록은 순서와 관계없이 저장할 수 있으며, 반환하는 트리의 Children은 비어 있습니다.
+	RecordVisit(ctx context.Context, visit *models.Visit) (*models.Tree, error)
+	// FindDuplicateTree는 숲에서 휴지통에 없고 정규화한 url이 canonicalURL인 트리를 이름, ID 순으로 하나 반환합니다.
+	// canonical_url이 없는 이전 트리는 url로 비교하며, 없으면 ErrTreeNotFound를 반환합니다.
+	FindDuplicateTree(ctx context.Context, forestID string, canonicalURL string) (*models.Tree, error)
+	// GetForestOwner와 GetTreeOwner는 권한 확인용으로, 휴지통 여부와 관계없이 숲의 소유자 ID를 반환합니다.
+	// GetTreeOwner는 트리가 속한 숲의 ID도 함께 반환합니다.
+	GetForestOwner(ctx context.Context, forestID string) (userID string, err error)
+	GetTreeOwner(ctx context.Context, treeID string) (forestID string, userID string, err error)
+	// GetMemberRole은 숲을 공유받은 사용자의 역할을 반환합니다. 멤버가 아니면 ""를 반환하며, 휴지통 여부와 관계없이 조회합니다.
+	GetMemberRole(ctx context.Context, forestID string, userID string) (models.ForestRole, error)
+	// ShareForest는 숲을 userID에게 role로 공유합니다. 이미 멤버면 역할을 바꿉니다.
+	ShareForest(ctx context.Context, forestID string, userID string, role models.ForestRole) (*models.ForestMember, error)
+	UnshareForest(ctx context.Context, forestID string, userID string) error
+	// ListForestMembers는 소유자를 제외한 멤버를 사용자 ID 순으로 반환합니다.
+	ListForestMembers(ctx context.Context, forestID string) ([]*models.ForestMember, error)
+
+	// CreateShareLink는 숲의 공개 링크를 만들고 토큰 원문을 담아 반환합니다. 저장소에는 토큰의 해시만 저장합니다.
+	CreateShareLink(ctx context.Context, forestID string, expiresAt time.Time) (*models.ShareLink, error)
+	// GetShareLink는 토큰에 해당하는 링크를 반환합니다. 만료되었거나 숲이 휴지통에 있으면 ErrShareLinkNotFound를 반환합니다.
+	GetShareLink(ctx context.Context, token string) (*models.ShareLink, error)
+	// ListShareLinks는 만료되지 않은 링크를 생성 순으로 반환합니다. Token은 비어 있습니다.
+	ListShareLinks(ctx context.Context, forestID string) ([]*models.ShareLink, error)
+	RevokeShareLink(ctx context.Context, forestID string, linkID string) error
+
+	// AddForestTags, AddTreeTags, RemoveForestTags, RemoveTreeTags는 숲 또는 트리의 태그를 바꾸고 변경 후 전체 태그를 이름 순으로 반환합니다.
+	// 태그는 숲 소유자의 것으로 저장되며, 태그가 올바르지 않으면 ErrInvalidTag를 반환합니다.
+	AddForestTags(ctx context.Context, forestID string, tags []string) ([]string, error)
+	AddTreeTags(ctx context.Context, treeID string, tags []string) ([]string, error)
+	RemoveForestTags(ctx context.Context, forestID string, tags []string) ([]string, error)
+	RemoveTreeTags(ctx context.Context, treeID string, tags []string) ([]string, error)
+	// ListTags는 사용자의 태그 중 휴지통에 없는 숲이나 트리에 붙어 있는 태그를 이름 순으로 반환합니다.
+	ListTags(ctx context.Context, userID string) ([]*models.TagCount, error)
+	// FindTreesByTag는 사용자의 모든 숲에서 태그가 붙은 트리를 이름, ID 순으로 반환합니다. Tree.Children은 비어 있습니다.
+	FindTreesByTag(ctx context.Context, userID string, tag string) ([]*models.ForestTree, error)
+	// FindTreesByUrl은 사용자가 소유하거나 공유받은 모든 숲에서 정규화한 url이 canonicalURL인 트리를 찾습니다.
+	// canonical_url이 없는 이전 트리는 url로 비교하며, 숲 이름, 숲 ID, 깊이, 트리 ID 순으로 반환합니다.
+	FindTreesByUrl(ctx context.Context, userID string, canonicalURL string) ([]*models.UrlMatch, error)
+	// BackfillCanonicalUrls는 canonical_url이 없는 이전 트리의 canonical_url을 canonicalize(url)로 채우고 채운 트리 수를 반환합니다.
+	BackfillCanonicalUrls(ctx context.Context, canonicalize func(string) string) (int, error)
+	// SearchTrees는 검색 범위의 트리 중 이름, URL, 요약을 합쳐 terms를 모두 포함하는 트리를 최대 limit개 반환합니다. 순서는 정해져 있지 않습니다.
+	// terms는 소문자로 바꾼 검색어 단어입니다.
+	SearchTrees(ctx context.Context, userID string, terms []string, opts SearchOptions, limit int) ([]*models.ForestTree, error)
+	// TreesInScope는 treeIDs 중 휴지통에 없고 검색 범위에 있는 트리만 반환합니다.
+	TreesInScope(ctx context.Context, userID string, treeIDs []string, opts SearchOptions) ([]*models.ForestTree, error)
+	// WalkForest는 숲의 트리를 루트부터 너비 우선으로 하나씩 visit에 넘깁니다.
+	// 같은 깊이에서는 부모, 형제 순서대로 방문하며 visit이 에러를 반환하면 중단하고 그 에러를 반환합니다.
+	WalkForest(ctx context.Context, forestID string, visit func(node *models.TreeNode) error) error
+	// ListChildren은 휴지통에 없는 직계 자식을 형제 순서대로 한 페이지 반환합니다. 각 자식에는 ChildCount가 채워집니다.
+	ListChildren(ctx context.Context, parentID string, pageSize int, pageToken string) (children []*models.Tree, nextPageToken string, err error)
+	// DeleteTree는 트리를 휴지통으로 옮깁니다. cascade가 false면 자식들을 트리의 자리에 부모로 옮기고 트리만 휴지통으로 옮깁니다.
+	DeleteTree(ctx context.Context, treeID string, cascade bool) ([]string, error)
+	// ReorderChildren은 부모의 자식 순서를 childIDs 순서로 바꾸고, 하위 트리를 포함한 부모 트리를 반환합니다.
+	ReorderChildren(ctx context.Context, parentID string, childIDs []string) (*models.Tree, error)
+	// MoveTree는 트리와 그 하위 트리 전체를 newParentID 아래로 옮기고 관련된 숲의 depth/total_trees를 다시 계산합니다.
+	MoveTree(ctx context.Context, treeID string, newParentID string) (*models.Tree, error)
+	// SplitForest는 treeID를 루트로 하는 하위 트리를 떼어내 같은 사용자의 새 숲으로 만듭니다.
+	SplitForest(ctx context.Context, treeID string, forest *models.Forest) (*models.Forest, error)
+	// GraftForest는 숲의 루트 트리를 targetTreeID 아래로 옮기고 원래 숲을 삭제한 뒤, 대상 숲을 반환합니다.
+	// 원래 숲의 공개 링크, 멤버와 숲 태그는 숲과 함께 삭제되며 트리의 태그는 유지됩니다.
+	GraftForest(ctx context.Context, forestID string, targetTreeID string) (*models.Forest, error)
+	// CopyTree는 treeID의 하위 트리 전체를 새 ID로 복사해 targetParentID 아래에 붙입니다.
+	// 복사된 트리와 원본 ID → 새 ID 매핑을 반환합니다.
+	CopyTree(ctx context.Context, treeID string, targetParentID string) (*models.Tree, map[string]string, error)
+	// CloneForest는 숲 전체를 같은 사용자의 새 숲으로 복사합니다. name이 비어 있으면 원본 이름을 사용합니다.
+	CloneForest(ctx context.Context, forestID string, name string) (*models.Forest, map[string]string, error)
+	// ImportForest는 forest.Root의 하위 트리 전체로 새 숲을 만들고 visits를 방문 기록으로 저장합니다.
+	// 트리 ID는 그대로 사용하며, 숲 ID, depth, total_trees와 생성/수정 시각은 저장소가 채웁니다.
+	ImportForest(ctx context.Context, forest *models.Forest, visits []*models.Visit) error
+
+	// ListTrash는 휴지통에 있는 숲과 트리를 반환합니다. userID가 ""면 모든 사용자의 항목을 반환합니다.
+	ListTrash(ctx context.Context, userID string) ([]*models.TrashItem, error)
+	RestoreForest(ctx context.Context, forestID string) (*models.Forest, error)
+	// RestoreTree는 트리를 휴지통에서 꺼냅니다. cascade 없이 삭제된 트리는 그때 부모로 옮겨진 자식 중
+	// 아직 같은 부모 아래에 있는 자식들을 원래 순서대로 다시 데려오고, 부모의 형제 순서에서 자식들의 자리를 되찾습니다.
+	RestoreTree(ctx context.Context, treeID string) (*models.Tree, error)
+	// PurgeForest와 PurgeTree는 휴지통 여부와 관계없이 영구 삭제하고 삭제된 트리 ID를 반환합니다.
+	PurgeForest(ctx context.Context, forestID string) ([]string, error)
+	PurgeTree(ctx context.Context, treeID string) ([]string, error)
+}
+
+// ForestSort는 숲 목록의 정렬 기준입니다.
+type ForestSort string
+
+const (
+	ForestSortName      ForestSort = "name"
+	ForestSortCreatedAt ForestSort = "created_at"
+	ForestSortUpdatedAt ForestSort = "updated_at"
+)
+
+const (
+	DefaultForestPageSize = 50
+	MaxForestPageSize     = 500
+
+	DefaultChildrenPageSize = 100
+	MaxChildrenPageSize     = 1000
+)
+
+// ForestListOptions는 GetForestByUser의 페이지, 정렬, 필터 조건입니다.
+type ForestListOptions struct {
+	IncludeChildren bool
+	PageSize        int    // 0 이하면 DefaultForestPageSize, MaxForestPageSize를 넘으면 MaxForestPageSize
+	PageToken       string // 이전 페이지의 nextPageToken
+	SortBy          ForestSort
+	Descending      bool
+	NamePrefix      string
+	MinTrees        int32 // total_trees가 이 값 이상인 숲만 반환
+	IncludeShared   bool  // 다른 사용자가 공유한 숲도 포함
+}
+
+// SearchScope는 검색할 숲의 범위입니다.
+type SearchScope string
+
+const (
+	SearchScopeAll    SearchScope = "all" // 소유한 숲과 공유받은 숲
+	SearchScopeOwned  SearchScope = "owned"
+	SearchScopeShared SearchScope = "shared"
+)
+
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+)
+
+// SearchOptions는 Store.Search의 범위와 결과 수입니다.
+type SearchOptions struct {
+	Scope    SearchScope // ""면 SearchScopeAll
+	ForestID string      // 지정하면 해당 숲에서만 검색
+	Limit    int         // 0 이하면 DefaultSearchLimit, MaxSearchLimit를 넘으면 MaxSearchLimit
+}
+
+// MemoStore는 메모 저장소가 제공해야 하는 동작을 정의합니다.
+// SupabaseStore, PostgresMemoStore, MemoryMemoStore가 이를 구현합니다.
+type MemoStore interface {
+	CreateMemo(ctx context.Context, userID string, treeID string, options map[string]interface{}) (*models.Memo, error)
+	GetMemo(ctx context.Context, userID string, treeID string) (*models.Memo, error)
+	// GetMemos는 treeIDs 중 메모가 있는 트리의 메모를 한 번에 조회합니다. 메모가 없는 트리는 결과에서 빠집니다.
+	GetMemos(ctx context.Context, userID string, treeIDs []string) ([]*models.Memo, error)
+	// UpdateMemo는 저장된 버전이 expectedVersion과 같을 때만 내용을 바꾸고 버전을 1 올립니다.
+	// 버전이 다르면 ErrMemoVersionConflict를 반환하며, 비교와 갱신은 원자적으로 수행되어야 합니다.
+	UpdateMemo(ctx context.Context, userID string, treeID string, content string, expectedVersion int32) (*models.Memo, error)
+	DeleteMemo(ctx context.Context, userID string, treeID string) (*models.Memo, error)
+	// SearchMemos는 userIDs의 메모 중 terms를 모두 대소문자 구분 없이 포함하는 메모를 (user_id, tree_id) 순으로
+	// offset개 건너뛴 뒤 최대 limit개 반환합니다.
+	SearchMemos(ctx context.Context, userIDs []string, terms []string, offset int, limit int) ([]*models.Memo, error)
+}
+
+var (
+	_ ForestRepository = (*Neo4jStore)(nil)
+	_ ForestRepository = (*MemoryForestStore)(nil)
+
+	_ MemoStore = (*SupabaseStore)(nil)
+	_ MemoStore = (*PostgresMemoStore)(nil)
+	_ MemoStore = (*MemoryMemoStore)(nil)
 )
 
 type Store struct {
-	Neo4j    *Neo4jStore    // Neo4j 로직을 담당하는 구조체
-	Supabase *SupabaseStore // Supabase 로직을 담당하는 구조체
+	Forest ForestRepository // 숲/트리 로직을 담당하는 저장소 (Neo4j 또는 인메모리)
+	Memo   MemoStore        // 메모 로직을 담당하는 저장소 (Supabase, PostgreSQL 또는 인메모리)
 }
 
-func NewStore(neo4jDriver neo4j.DriverWithContext, supabaseClient *supabase.Client) *Store {
-	neo4jStore, _ := NewNeo4jStore(neo4jDriver)
-	supabaseStore, _ := NewSupabaseStore(supabaseClient)
+func NewStore(forestRepo ForestRepository, memoStore MemoStore) *Store {
 	return &Store{
-		Neo4j:    neo4jStore,
-		Supabase: supabaseStore,
+		Forest: forestRepo,
+		Memo:   memoStore,
 	}
 }
 
-func (s *Store) Close(ctx context.Context) {
-	s.Neo4j.Close(ctx)
+// PurgeTrash는 deletedBefore 이전에 휴지통으로 옮겨진 항목과 그 메모를 영구 삭제하고, 삭제한 항목 수를 반환합니다.
+// userID가 ""면 모든 사용자의 휴지통을 비웁니다.
+func (s *Store) PurgeTrash(ctx context.Context, userID string, deletedBefore time.Time) (int, error) {
+	items, err := s.Forest.ListTrash(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	purged := 0
+	var errs []error
+	for _, item := range items {
+		if !item.DeletedAt.Before(deletedBefore) {
+			continue
+		}
+		var treeIDs []string
+		if item.Type == models.TrashItemForest {
+			treeIDs, err = s.Forest.PurgeForest(ctx, item.Id)
+		} else {
+			treeIDs, err = s.Forest.PurgeTree(ctx, item.Id)
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		purged++
+		for _, treeID := range treeIDs {
+			if _, err := s.Memo.DeleteMemo(ctx, item.UserId, treeID); err != nil && !errors.Is(err, ErrMemoNotFound) {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return purged, errors.Join(errs...)
+}
+
+// Search는 userID가 접근할 수 있는 숲에서 트리 이름, URL, 요약과 메모 내용을 검색해 점수 내림차순으로 반환합니다.
+// 트리 필드와 메모는 각각 따로 검색되므로 같은 트리가 두 번 나올 수 있습니다.
+func (s *Store) Search(ctx context.Context, userID string, query string, opts SearchOptions) ([]*models.SearchHit, error) {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("%w: no words", ErrInvalidQuery)
+	}
+	opts, err := opts.normalize()
+	if err != nil {
+		return nil, err
+	}
+	// 저장소 검색 결과를 다시 점수 매겨 자르므로 넉넉히 조회
+	candidates := opts.Limit * searchCandidateFactor
+
+	trees, err := s.Forest.SearchTrees(ctx, userID, terms, opts, candidates)
+	if err != nil {
+		return nil, err
+	}
+	var hits []*models.SearchHit
+	for _, tree := range trees {
+		if hit := treeSearchHit(tree, terms); hit != nil {
+			hits = append(hits, hit)
+		}
+	}
+
+	memoHits, err := s.searchMemoHits(ctx, userID, terms, opts, candidates)
+	if err != nil {
+		return nil, err
+	}
+	hits = append(hits, memoHits...)
+
+	sortSearchHits(hits)
+	if len(hits) > opts.Limit {
+		hits = hits[:opts.Limit]
+	}
+	return hits, nil
+}
+
+// searchMemoHits는 검색 범위 안의 트리에 달린 메모에서 찾은 결과를 최대 candidates개 반환합니다.
+// 메모는 숲 소유자 ID로 저장되므로 범위 안의 숲 소유자들의 메모를 검색한 뒤 범위 밖이거나 휴지통에 있는 트리를 걸러냅니다.
+// 걸러진 메모가 후보 수를 차지해 범위 안의 결과가 빠지지 않도록 후보가 다 찰 때까지 다음 페이지를 이어서 조회합니다.
+func (s *Store) searchMemoHits(ctx context.Context, userID string, terms []string, opts SearchOptions, candidates int) ([]*models.SearchHit, error) {
+	owners, err := s.searchOwners(ctx, userID, opts)
+	if err != nil {
+		return nil, err
+	}
+	var hits []*models.SearchHit
+	for offset := 0; len(hits) < candidates; offset += candidates {
+		memos, err := s.Memo.SearchMemos(ctx, owners, terms, offset, candidates)
+		if err != nil {
+			return nil, err
+		}
+		treeIDs := make([]string, len(memos))
+		for i, memo := range memos {
+			treeIDs[i] = memo.TreeID
+		}
+		located, err := s.Forest.TreesInScope(ctx, userID, treeIDs, opts)
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[string]*models.ForestTree, len(located))
+		for _, tree := range located {
+			byID[tree.Tree.Id] = tree
+		}
+		for _, memo := range memos {
+			if tree, ok := byID[memo.TreeID]; ok && len(hits) < candidates {
+				hits = append(hits, memoSearchHit(tree, memo.Content, terms))
+			}
+		}
+		if len(memos) < candidates {
+			break
+		}
+	}
+	return hits, nil
+}
+
+// searchOwners는 검색 범위에 있는 숲의 소유자 ID를 반환합니다.
+func (s *Store) searchOwners(ctx context.Context, userID string, opts SearchOptions) ([]string, error) {
+	if opts.ForestID != "" {
+		ownerID, err := s.Forest.GetForestOwner(ctx, opts.ForestID)
+		if err != nil {
+			return nil, err
+		}
+		return []string{ownerID}, nil
+	}
+	var owners []string
+	if opts.Scope != SearchScopeShared {
+		owners = append(owners, userID)
+	}
+	if opts.Scope == SearchScopeOwned {
+		return owners, nil
+	}
+	listOpts := ForestListOptions{PageSize: MaxForestPageSize, IncludeShared: true}
+	for {
+		forests, nextPageToken, err := s.Forest.GetForestByUser(ctx, userID, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range forests {
+			if f.UserId != userID && !slices.Contains(owners, f.UserId) {
+				owners = append(owners, f.UserId)
+			}
+		}
+		if nextPageToken == "" {
+			return owners, nil
+		}
+		listOpts.PageToken = nextPageToken
+	}
+}
+
+func (s *Store) Close(ctx context.Context) error {
+	var errs []error
+	for _, backend := range []interface{}{s.Forest, s.Memo} {
+		if closer, ok := backend.(interface{ Close(context.Context) error }); ok {
+			errs = append(errs, closer.Close(ctx))
+		}
+	}
+	return errors.Join(errs...)
 }
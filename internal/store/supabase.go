@@ -1,14 +1,22 @@
 package store
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/jdk829355/InForest_back/config"
 	"github.com/jdk829355/InForest_back/models"
 	"github.com/supabase-community/supabase-go"
 )
 
+// SupabaseStore는 Supabase의 memo 테이블을 사용하는 MemoStore 구현체입니다.
+// 테이블 구조는 InitPostgresMemoStore가 만드는 테이블과 같아야 하며,
+// 이전 테이블에는 migrations/001_memo_timestamps.sql을 실행해 created_at, updated_at 컬럼을 추가해야 하며,
+// 컬럼이 없으면 쓰기가 실패합니다.
 type SupabaseStore struct {
 	client *supabase.Client
 }
@@ -43,18 +51,8 @@ TODO: 추가해야할 메서드
 - 요약 수정: db에서 요약 수정
 */
 
-func (s *SupabaseStore) CreateMemo(user_id string, tree_id string, options map[string]interface{}) (*models.Memo, error) {
-	if options == nil {
-		options = make(map[string]interface{})
-		options["content"] = ""
-		options["version"] = int32(0)
-	}
-	memo := &models.Memo{
-		TreeID:  tree_id,
-		UserID:  user_id,
-		Content: options["content"].(string),
-		Version: options["version"].(int32),
-	}
+func (s *SupabaseStore) CreateMemo(ctx context.Context, user_id string, tree_id string, options map[string]interface{}) (*models.Memo, error) {
+	memo := newMemoFromOptions(user_id, tree_id, options)
 
 	var mapData map[string]interface{}
 	marshaledData, err := json.Marshal(memo)
@@ -68,25 +66,41 @@ func (s *SupabaseStore) CreateMemo(user_id string, tree_id string, options map[s
 
 	_, _, err = s.client.From("memo").Insert(mapData, false, "", "", "").Execute()
 	if err != nil {
-		return nil, err
+		return nil, missingTimestampColumn(err)
 	}
 
 	return memo, nil
 }
 
-func (s *SupabaseStore) GetMemo(user_id string, tree_id string) (*models.Memo, error) {
+func (s *SupabaseStore) GetMemo(ctx context.Context, user_id string, tree_id string) (*models.Memo, error) {
 	var memos []models.Memo
 	count, err := s.client.From("memo").Select("*", "exact", false).Eq("user_id", user_id).Eq("tree_id", tree_id).ExecuteTo(&memos)
 	if err != nil {
 		return nil, err
 	}
-	if count == 0 {
-		return nil, fmt.Errorf("memo does not exist") // 메모가 없는 경우
+	if count == 0 || len(memos) == 0 {
+		return nil, ErrMemoNotFound // 메모가 없는 경우
 	}
 	return &memos[0], nil
 }
 
-func (s *SupabaseStore) DeleteMemo(user_id string, tree_id string) (*models.Memo, error) {
+// supabaseInBatch는 GetMemos가 한 요청의 in 필터에 넣는 최대 트리 수입니다. (요청 URL 길이 제한)
+const supabaseInBatch = 200
+
+// GetMemos는 트리 ID를 supabaseInBatch개씩 in 필터로 묶어 조회합니다.
+func (s *SupabaseStore) GetMemos(ctx context.Context, user_id string, tree_ids []string) ([]*models.Memo, error) {
+	var memos []*models.Memo
+	for batch := range slices.Chunk(tree_ids, supabaseInBatch) {
+		var found []*models.Memo
+		if _, err := s.client.From("memo").Select("*", "", false).Eq("user_id", user_id).In("tree_id", batch).ExecuteTo(&found); err != nil {
+			return nil, err
+		}
+		memos = append(memos, found...)
+	}
+	return memos, nil
+}
+
+func (s *SupabaseStore) DeleteMemo(ctx context.Context, user_id string, tree_id string) (*models.Memo, error) {
 	var memos []models.Memo
 	data, _, err := s.client.From("memo").Delete("", "").Eq("user_id", user_id).Eq("tree_id", tree_id).Execute()
 	if err != nil {
@@ -96,13 +110,57 @@ func (s *SupabaseStore) DeleteMemo(user_id string, tree_id string) (*models.Memo
 	if err != nil {
 		return nil, err
 	}
+	if len(memos) == 0 {
+		return nil, ErrMemoNotFound
+	}
 	return &memos[0], nil
 }
 
-func (s *SupabaseStore) UpdateMemo(user_id string, tree_id string, content string, version int32) (*models.Memo, error) {
-	_, _, err := s.client.From("memo").Update(map[string]interface{}{"content": content, "version": version}, "", "").Eq("user_id", user_id).Eq("tree_id", tree_id).Execute()
+// UpdateMemo는 version 조건을 PATCH 필터에 포함해 PostgREST가 하나의 UPDATE 문으로 비교와 갱신을 처리하도록 합니다.
+func (s *SupabaseStore) UpdateMemo(ctx context.Context, user_id string, tree_id string, content string, expectedVersion int32) (*models.Memo, error) {
+	var memos []models.Memo
+	values := map[string]interface{}{"content": content, "version": expectedVersion + 1, "updated_at": time.Now().UTC()}
+	data, _, err := s.client.From("memo").
+		Update(values, "representation", "").
+		Eq("user_id", user_id).Eq("tree_id", tree_id).Eq("version", fmt.Sprint(expectedVersion)).
+		Execute()
 	if err != nil {
+		return nil, missingTimestampColumn(err)
+	}
+	if err := json.Unmarshal(data, &memos); err != nil {
 		return nil, err
 	}
-	return s.GetMemo(user_id, tree_id)
+	if len(memos) == 0 {
+		// 갱신된 행이 없으면 메모가 없거나 버전이 다른 경우
+		if _, err := s.GetMemo(ctx, user_id, tree_id); err != nil {
+			return nil, err
+		}
+		return nil, ErrMemoVersionConflict
+	}
+	return &memos[0], nil
+}
+
+// SearchMemos는 단어마다 ilike 필터를 걸어 모든 단어를 포함하는 메모를 찾습니다.
+func (s *SupabaseStore) SearchMemos(ctx context.Context, user_ids []string, terms []string, offset int, limit int) ([]*models.Memo, error) {
+	var memos []*models.Memo
+	query := s.client.From("memo").Select("*", "", false).In("user_id", user_ids)
+	for _, term := range terms {
+		query = query.Ilike("content", "*"+escapeLike(term)+"*")
+	}
+	if _, err := query.Order("user_id", nil).Order("tree_id", nil).Range(offset, offset+limit-1, "").ExecuteTo(&memos); err != nil {
+		return nil, err
+	}
+	return memos, nil
+}
+
+// missingTimestampColumn은 memo 테이블에 created_at/updated_at 컬럼이 없어 쓰기가 실패했으면 적용할 마이그레이션을 알려 주는 에러로 감쌉니다.
+// PostgREST는 본문에 없는 컬럼이 있으면 PGRST204(이전 버전은 42703)를 반환합니다.
+func missingTimestampColumn(err error) error {
+	msg := err.Error()
+	missing := (strings.Contains(msg, "PGRST204") || strings.Contains(msg, "42703")) &&
+		(strings.Contains(msg, "created_at") || strings.Contains(msg, "updated_at"))
+	if !missing {
+		return err
+	}
+	return fmt.Errorf("memo table has no timestamp columns, apply internal/store/migrations/001_memo_timestamps.sql: %w", err)
 }
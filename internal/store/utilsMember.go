@@ -0,0 +1,25 @@
+package store
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+// checkShare는 ownerID의 숲을 userID에게 role로 공유할 수 있는지 확인합니다.
+func checkShare(ownerID string, userID string, role models.ForestRole) error {
+	if userID == "" || userID == ownerID {
+		return ErrInvalidShare
+	}
+	if role != models.ForestRoleViewer && role != models.ForestRoleEditor {
+		return ErrInvalidShare
+	}
+	return nil
+}
+
+func sortMembers(members []*models.ForestMember) {
+	slices.SortFunc(members, func(a, b *models.ForestMember) int {
+		return strings.Compare(a.UserId, b.UserId)
+	})
+}
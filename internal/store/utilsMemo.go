@@ -0,0 +1,28 @@
+package store
+
+import (
+	"time"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+// 유틸함수
+
+// newMemoFromOptions는 CreateMemo의 options로부터 메모를 만듭니다.
+// options가 nil이면 빈 메모(버전 0)를 만듭니다. 생성/수정 시각은 현재 시각입니다.
+func newMemoFromOptions(userID string, treeID string, options map[string]interface{}) *models.Memo {
+	now := time.Now().UTC()
+	memo := &models.Memo{
+		TreeID:    treeID,
+		UserID:    userID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if content, ok := options["content"].(string); ok {
+		memo.Content = content
+	}
+	if version, ok := options["version"].(int32); ok {
+		memo.Version = version
+	}
+	return memo
+}
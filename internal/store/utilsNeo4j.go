@@ -3,29 +3,101 @@ package store
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/jdk829355/InForest_back/models"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
 // 유틸함수
-func getDerived(tree_from *models.Tree, ctx context.Context, session neo4j.SessionWithContext, s *Neo4jStore) error {
-	cypher := `MATCH (parent:Tree {id: $parent_id})-[:derived]->(child:Tree) RETURN child.id AS id, child.name AS name, child.url AS url`
+
+// queryRunner는 세션과 트랜잭션에서 공통으로 쿼리를 실행하기 위한 인터페이스입니다.
+type queryRunner interface {
+	Run(ctx context.Context, cypher string, params map[string]any) (neo4j.ResultWithContext, error)
+}
+
+// sessionRunner는 자동 커밋 세션을 queryRunner로 사용하기 위한 어댑터입니다.
+type sessionRunner struct {
+	neo4j.SessionWithContext
+}
+
+func (r sessionRunner) Run(ctx context.Context, cypher string, params map[string]any) (neo4j.ResultWithContext, error) {
+	return r.SessionWithContext.Run(ctx, cypher, params)
+}
+
+// neo4jTime은 Neo4j에 보낼 시각을 UTC로 바꿉니다.
+// 드라이버는 time.Time의 Location().String()을 시간대 ID로 보내는데, time.Now()의 "Local"이나
+// time.Parse가 만든 이름 없는 고정 오프셋은 서버가 해석하지 못해 쿼리가 실패합니다.
+// 메모리 저장소로는 드러나지 않으므로 쿼리에 바인딩하는 시각은 모두 이 함수를 거쳐야 합니다.
+func neo4jTime(t time.Time) time.Time {
+	return t.UTC()
+}
+
+// getDerived는 roots 아래의 모든 하위 트리를 가변 길이 경로 쿼리 한 번으로 조회한 뒤
+// Go에서 트리 구조로 조립합니다. 형제 트리는 derived 관계의 position 순으로 정렬되며 휴지통에 있는 트리는 제외됩니다.
+// position이 없는 관계(이전 데이터)는 뒤쪽에 이름, ID 순으로 정렬됩니다.
+// maxDepth가 0보다 크면 roots 아래로 maxDepth 단계까지만 조회합니다.
+func (s *Neo4jStore) getDerived(ctx context.Context, runner queryRunner, maxDepth int, roots ...*models.Tree) error {
+	if len(roots) == 0 {
+		return nil
+	}
+	nodes := make(map[string]*models.Tree, len(roots))
+	rootIDs := make([]string, 0, len(roots))
+	for _, root := range roots {
+		nodes[root.Id] = root
+		rootIDs = append(rootIDs, root.Id)
+	}
+
+	// 가변 길이 관계의 상한은 파라미터로 넘길 수 없으므로 숫자를 직접 넣음
+	upper := ""
+	if maxDepth > 0 {
+		upper = strconv.Itoa(maxDepth - 1)
+	}
+	cypher := `MATCH (root:Tree) WHERE root.id IN $root_ids
+	MATCH p = (root)-[:derived*0..` + upper + `]->(parent:Tree)-[r:derived]->(child:Tree)
+	WHERE all(n IN nodes(p) WHERE n.deleted_at IS NULL)
+	RETURN DISTINCT parent.id AS parent_id, ` + treeColumns("child") + `, r.position AS position
+	ORDER BY position, name, id`
 	parameters := map[string]interface{}{
-		"parent_id": tree_from.Id,
+		"root_ids": rootIDs,
 	}
-	derivedResult, err := session.Run(ctx, cypher, parameters)
+	result, err := runner.Run(ctx, cypher, parameters)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to run derived query: %w", err)
+	}
+
+	type edge struct {
+		parentID string
+		child    *models.Tree
 	}
-	for derivedResult.Next(ctx) {
-		record := derivedResult.Record()
+	var edges []edge
+	for result.Next(ctx) {
+		record := result.Record()
 		child, err := s.parseTreeRecord(record)
 		if err != nil {
-			return err
+			return fmt.Errorf("failed to parse tree record: %w", err)
+		}
+		parentID, _, err := neo4j.GetRecordValue[string](record, "parent_id")
+		if err != nil {
+			return fmt.Errorf("failed to parse tree record: %w", err)
 		}
-		tree_from.Children = append(tree_from.Children, child)
-		getDerived(child, ctx, session, s)
+		nodes[child.Id] = child
+		edges = append(edges, edge{parentID: parentID, child: child})
+	}
+	if err := result.Err(); err != nil {
+		return fmt.Errorf("failed to run derived query: %w", err)
+	}
+
+	// 쿼리 결과 순서대로 부모에 연결하므로 형제 순서가 항상 같음
+	for _, e := range edges {
+		parent, ok := nodes[e.parentID]
+		if !ok {
+			return fmt.Errorf("parent tree %s not found while assembling subtree", e.parentID)
+		}
+		parent.Children = append(parent.Children, e.child)
 	}
 	return nil
 }
@@ -33,6 +105,7 @@ func getDerived(tree_from *models.Tree, ctx context.Context, session neo4j.Sessi
 func (s *Neo4jStore) parseForestRecord(record *neo4j.Record) (*models.Forest, error) {
 	forest := &models.Forest{}
 	var ok bool
+	var err error
 
 	if forestData, exists := record.Get("id"); exists {
 		forest.Id, ok = forestData.(string)
@@ -72,39 +145,380 @@ func (s *Neo4jStore) parseForestRecord(record *neo4j.Record) (*models.Forest, er
 			return nil, fmt.Errorf("invalid type for forest user_id")
 		}
 	}
+	if forestData, exists := record.Get("created_at"); exists && forestData != nil {
+		forest.CreatedAt, ok = forestData.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("invalid type for forest created_at")
+		}
+	}
+	if forestData, exists := record.Get("updated_at"); exists && forestData != nil {
+		forest.UpdatedAt, ok = forestData.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("invalid type for forest updated_at")
+		}
+	}
+	if forestData, exists := record.Get("tags"); exists {
+		if forest.Tags, err = parseTags(forestData); err != nil {
+			return nil, fmt.Errorf("invalid type for forest tags")
+		}
+	}
 	forest.Root = nil // 트리 구조는 별도로 처리 필요
 
 	return forest, nil
 }
 
 func (s *Neo4jStore) parseTreeRecord(record *neo4j.Record) (*models.Tree, error) {
+	return parseTreeValues(record.Get)
+}
+
+// parseRootTree는 `t {.id, .name, ..., tags: [...]} AS root` 형태로 반환된 루트 트리를 파싱합니다.
+// 루트 트리가 없으면 nil을 반환합니다.
+func (s *Neo4jStore) parseRootTree(record *neo4j.Record) (*models.Tree, error) {
+	rootData, _, err := neo4j.GetRecordValue[map[string]any](record, "root")
+	if err != nil || rootData == nil {
+		return nil, err
+	}
+	return parseTreeValues(func(key string) (any, bool) {
+		value, exists := rootData[key]
+		return value, exists
+	})
+}
+
+// parseTreeValues가 읽는 트리 속성. 필드를 추가할 때는 이 목록과 parseTreeValues만 고치면 됩니다.
+var treeFields = []string{
+	"id", "name", "url", "summary", "canonical_url",
+	"first_visited_at", "last_visited_at", "visit_count", "total_dwell_ms",
+	"created_at", "updated_at",
+}
+
+// treeColumns는 노드 변수 v의 트리 필드를 RETURN 컬럼 목록(`v.id AS id, ..., tags`)으로 만듭니다.
+func treeColumns(v string) string {
+	columns := make([]string, 0, len(treeFields)+1)
+	for _, field := range treeFields {
+		columns = append(columns, v+"."+field+" AS "+field)
+	}
+	columns = append(columns, "[("+v+")-[:tagged]->(g:Tag) | g.name] AS tags")
+	return strings.Join(columns, ", ")
+}
+
+// treeMap은 같은 필드를 맵 프로젝션(`v {.id, ..., tags: [...]}`)으로 만듭니다. parseRootTree가 읽습니다.
+func treeMap(v string) string {
+	entries := make([]string, 0, len(treeFields)+1)
+	for _, field := range treeFields {
+		entries = append(entries, "."+field)
+	}
+	entries = append(entries, "tags: [("+v+")-[:tagged]->(g:Tag) | g.name]")
+	return v + " {" + strings.Join(entries, ", ") + "}"
+}
+
+func parseTreeValues(get func(key string) (any, bool)) (*models.Tree, error) {
 	tree := &models.Tree{}
 	var ok bool
+	var err error
 
-	if treeData, exists := record.Get("id"); exists {
+	if treeData, exists := get("id"); exists {
 		tree.Id, ok = treeData.(string)
 		if !ok {
 			return nil, fmt.Errorf("invalid type for tree id")
 		}
 	}
-	if treeData, exists := record.Get("name"); exists {
+	if treeData, exists := get("name"); exists {
 		tree.Name, ok = treeData.(string)
 		if !ok {
 			return nil, fmt.Errorf("invalid type for tree name")
 		}
 	}
-	if treeData, exists := record.Get("url"); exists {
+	if treeData, exists := get("url"); exists {
 		tree.Url, ok = treeData.(string)
 		if !ok {
 			return nil, fmt.Errorf("invalid type for tree url")
 		}
 	}
-	if treeData, exists := record.Get("summary"); exists {
+	if treeData, exists := get("summary"); exists {
 		tree.Summary, ok = treeData.(string)
 		if !ok {
 			return nil, fmt.Errorf("invalid type for tree summary")
 		}
 	}
+	// canonical_url이 없는 이전 데이터는 비워 둠
+	if treeData, exists := get("canonical_url"); exists && treeData != nil {
+		tree.CanonicalUrl, ok = treeData.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid type for tree canonical_url")
+		}
+	}
+	if treeData, exists := get("tags"); exists {
+		if tree.Tags, err = parseTags(treeData); err != nil {
+			return nil, fmt.Errorf("invalid type for tree tags")
+		}
+	}
+	// 방문 기록이 없는 트리는 방문 필드가 없음
+	if treeData, exists := get("first_visited_at"); exists && treeData != nil {
+		tree.FirstVisitedAt, ok = treeData.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("invalid type for tree first_visited_at")
+		}
+	}
+	if treeData, exists := get("last_visited_at"); exists && treeData != nil {
+		tree.LastVisitedAt, ok = treeData.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("invalid type for tree last_visited_at")
+		}
+	}
+	if treeData, exists := get("visit_count"); exists && treeData != nil {
+		visitCount, ok := treeData.(int64)
+		if !ok {
+			return nil, fmt.Errorf("invalid type for tree visit_count")
+		}
+		tree.VisitCount = int32(visitCount)
+	}
+	if treeData, exists := get("total_dwell_ms"); exists && treeData != nil {
+		tree.TotalDwellMs, ok = treeData.(int64)
+		if !ok {
+			return nil, fmt.Errorf("invalid type for tree total_dwell_ms")
+		}
+	}
+	// 시각이 없는 이전 데이터는 InitNeo4jStore에서 채우지만, 채우기 전에 조회해도 실패하지 않도록 함
+	if treeData, exists := get("created_at"); exists && treeData != nil {
+		tree.CreatedAt, ok = treeData.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("invalid type for tree created_at")
+		}
+	}
+	if treeData, exists := get("updated_at"); exists && treeData != nil {
+		tree.UpdatedAt, ok = treeData.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("invalid type for tree updated_at")
+		}
+	}
 	tree.Children = nil // 자식 트리는 별도로 처리 필요
 	return tree, nil
 }
+
+// parseTags는 `[(n)-[:tagged]->(g:Tag) | g.name]`으로 조회한 태그 목록을 이름 순으로 정렬해 반환합니다.
+func parseTags(value any) ([]string, error) {
+	values, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for tags: %T", value)
+	}
+	var tags []string
+	for _, v := range values {
+		tag, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid type for tag: %T", v)
+		}
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+	return tags, nil
+}
+
+// tagNodes는 label 노드마다 tags의 태그를 붙입니다. 태그는 userID의 :Tag 노드로 공유되며 없으면 새로 만듭니다.
+// tagged는 노드 ID → 태그 목록입니다.
+func tagNodes(ctx context.Context, tx neo4j.ManagedTransaction, label string, userID string, tagged map[string][]string) error {
+	if len(tagged) == 0 {
+		return nil
+	}
+	var rows []map[string]any
+	for id, tags := range tagged {
+		rows = append(rows, map[string]any{"id": id, "tags": tags})
+	}
+	// label은 Forest 또는 Tree 상수만 넘기므로 쿼리에 직접 넣어도 안전함
+	cypher := `UNWIND $rows AS row
+	MATCH (n:` + label + ` {id: row.id})
+	UNWIND row.tags AS name
+	MERGE (g:Tag {user_id: $user_id, name: name})
+	MERGE (n)-[:tagged]->(g)`
+	_, err := tx.Run(ctx, cypher, map[string]any{
+		"rows":    rows,
+		"user_id": userID,
+	})
+	return err
+}
+
+// recountForests는 숲의 트리 구조를 기준으로 depth/total_trees를 다시 계산하고 updated_at을 now로 바꿉니다.
+// 휴지통에 있는 트리와 그 하위 트리는 세지 않습니다.
+func recountForests(ctx context.Context, tx neo4j.ManagedTransaction, now time.Time, forestIDs ...string) error {
+	cypher := `UNWIND $forest_ids AS forest_id
+	MATCH (f:Forest {id: forest_id})
+	OPTIONAL MATCH p = (f)-[:derived*]->(t:Tree) WHERE all(n IN nodes(p)[1..] WHERE n.deleted_at IS NULL)
+	WITH f, count(t) AS total_trees, coalesce(max(length(p)), 0) AS max_depth
+	SET f.total_trees = total_trees, f.depth = max_depth, f.updated_at = $now`
+	_, err := tx.Run(ctx, cypher, map[string]interface{}{
+		"forest_ids": forestIDs,
+		"now":        neo4jTime(now),
+	})
+	return err
+}
+
+// readForest는 트랜잭션 안에서 숲과 루트 트리(자식 제외)를 조회합니다.
+func (s *Neo4jStore) readForest(ctx context.Context, tx neo4j.ManagedTransaction, forestID string) (*models.Forest, error) {
+	cypher := `MATCH (f:Forest {id: $forest_id}) WHERE f.deleted_at IS NULL
+	OPTIONAL MATCH (f)-[:derived]->(t:Tree) WHERE t.deleted_at IS NULL
+	RETURN f.user_id AS user_id, f.id AS id, f.name AS name, f.description AS description, f.depth AS depth, f.total_trees AS total_trees,
+		[(f)-[:tagged]->(g:Tag) | g.name] AS tags,
+		f.created_at AS created_at, f.updated_at AS updated_at,
+		` + treeMap("t") + ` AS root`
+	result, err := tx.Run(ctx, cypher, map[string]interface{}{
+		"forest_id": forestID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !result.Next(ctx) {
+		if err := result.Err(); err != nil {
+			return nil, err
+		}
+		return nil, ErrForestNotFound
+	}
+	forest, err := s.parseForestRecord(result.Record())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse forest record: %w", err)
+	}
+	forest.Root, err = s.parseRootTree(result.Record())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tree record: %w", err)
+	}
+	return forest, nil
+}
+
+// createSubtree는 root와 그 하위 트리의 노드와 관계를 생성하고, 트리의 태그를 userID의 태그로 붙입니다.
+// root를 부모(트리 또는 숲)에 연결하는 것은 호출하는 쪽에서 처리합니다.
+func createSubtree(ctx context.Context, tx neo4j.ManagedTransaction, userID string, root *models.Tree) error {
+	var nodes []map[string]any
+	var edges []map[string]any
+	tagged := map[string][]string{}
+	var walk func(t *models.Tree)
+	walk = func(t *models.Tree) {
+		nodes = append(nodes, map[string]any{"id": t.Id, "name": t.Name, "url": t.Url, "canonical_url": t.CanonicalUrl, "summary": t.Summary,
+			"created_at": neo4jTime(t.CreatedAt), "updated_at": neo4jTime(t.UpdatedAt)})
+		if len(t.Tags) > 0 {
+			tagged[t.Id] = t.Tags
+		}
+		for i, child := range t.Children {
+			edges = append(edges, map[string]any{"parent_id": t.Id, "child_id": child.Id, "position": i})
+			walk(child)
+		}
+	}
+	walk(root)
+
+	cypher := `UNWIND $nodes AS n
+	CREATE (:Tree {id: n.id, name: n.name, url: n.url, canonical_url: n.canonical_url, summary: n.summary, created_at: n.created_at, updated_at: n.updated_at})`
+	if _, err := tx.Run(ctx, cypher, map[string]any{"nodes": nodes}); err != nil {
+		return err
+	}
+	cypher = `UNWIND $edges AS e
+	MATCH (parent:Tree {id: e.parent_id})
+	MATCH (child:Tree {id: e.child_id})
+	CREATE (parent)-[:derived {position: e.position}]->(child)`
+	if _, err := tx.Run(ctx, cypher, map[string]any{"edges": edges}); err != nil {
+		return err
+	}
+	return tagNodes(ctx, tx, "Tree", userID, tagged)
+}
+
+// childOrder는 부모 트리의 자식 ID를 형제 순서대로 반환합니다. liveChildren은 휴지통에 없는 자식만 포함합니다.
+func childOrder(ctx context.Context, tx neo4j.ManagedTransaction, parentID string) ([]string, []string, error) {
+	cypher := `MATCH (:Tree {id: $parent_id})-[r:derived]->(c:Tree)
+	RETURN c.id AS id, c.deleted_at IS NULL AS live
+	ORDER BY r.position, c.name, c.id`
+	result, err := tx.Run(ctx, cypher, map[string]interface{}{
+		"parent_id": parentID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	var children, liveChildren []string
+	for result.Next(ctx) {
+		id, _, err := neo4j.GetRecordValue[string](result.Record(), "id")
+		if err != nil {
+			return nil, nil, err
+		}
+		live, _, err := neo4j.GetRecordValue[bool](result.Record(), "live")
+		if err != nil {
+			return nil, nil, err
+		}
+		children = append(children, id)
+		if live {
+			liveChildren = append(liveChildren, id)
+		}
+	}
+	return children, liveChildren, result.Err()
+}
+
+// setChildOrder는 childIDs 순서대로 부모와 자식 사이 derived 관계의 position을 저장합니다.
+func setChildOrder(ctx context.Context, tx neo4j.ManagedTransaction, parentID string, childIDs []string) error {
+	cypher := `UNWIND range(0, size($child_ids) - 1) AS i
+	MATCH (:Tree {id: $parent_id})-[r:derived]->(:Tree {id: $child_ids[i]})
+	SET r.position = i`
+	_, err := tx.Run(ctx, cypher, map[string]interface{}{
+		"parent_id": parentID,
+		"child_ids": childIDs,
+	})
+	return err
+}
+
+// renumberChildren은 부모의 자식 position을 0부터 다시 매깁니다.
+// position이 없는 새 관계는 기존 자식들 뒤에 놓입니다.
+func renumberChildren(ctx context.Context, tx neo4j.ManagedTransaction, parentID string) error {
+	children, _, err := childOrder(ctx, tx, parentID)
+	if err != nil {
+		return err
+	}
+	return setChildOrder(ctx, tx, parentID, children)
+}
+
+// getStringList는 record의 key에 해당하는 리스트 값을 []string으로 변환합니다.
+func getStringList(record *neo4j.Record, key string) ([]string, error) {
+	values, _, err := neo4j.GetRecordValue[[]any](record, key)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(values))
+	for _, value := range values {
+		id, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid type for %s: %T", key, value)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func parseTrashItemRecord(record *neo4j.Record) (*models.TrashItem, error) {
+	item := &models.TrashItem{}
+	var err error
+	for key, dst := range map[string]*string{
+		"type":      &item.Type,
+		"id":        &item.Id,
+		"name":      &item.Name,
+		"forest_id": &item.ForestId,
+		"user_id":   &item.UserId,
+	} {
+		if *dst, _, err = neo4j.GetRecordValue[string](record, key); err != nil {
+			return nil, err
+		}
+	}
+	if item.DeletedAt, _, err = neo4j.GetRecordValue[time.Time](record, "deleted_at"); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func parseShareLinkRecord(record *neo4j.Record) (*models.ShareLink, error) {
+	link := &models.ShareLink{}
+	var err error
+	if link.Id, _, err = neo4j.GetRecordValue[string](record, "id"); err != nil {
+		return nil, err
+	}
+	if link.ForestId, _, err = neo4j.GetRecordValue[string](record, "forest_id"); err != nil {
+		return nil, err
+	}
+	if link.ExpiresAt, _, err = neo4j.GetRecordValue[time.Time](record, "expires_at"); err != nil {
+		return nil, err
+	}
+	if link.CreatedAt, _, err = neo4j.GetRecordValue[time.Time](record, "created_at"); err != nil {
+		return nil, err
+	}
+	return link, nil
+}
@@ -0,0 +1,144 @@
+package store
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+// forestCursor는 숲 목록 페이지 토큰의 내용입니다. 마지막으로 반환한 숲의 정렬 키와 ID를 담습니다.
+type forestCursor struct {
+	SortBy     ForestSort `json:"s"`
+	Descending bool       `json:"d"`
+	Key        string     `json:"k"`
+	ID         string     `json:"id"`
+}
+
+// normalize는 기본값을 채우고 page size를 허용 범위로 맞춥니다.
+func (o ForestListOptions) normalize() (ForestListOptions, error) {
+	switch o.SortBy {
+	case "":
+		o.SortBy = ForestSortName
+	case ForestSortName, ForestSortCreatedAt, ForestSortUpdatedAt:
+	default:
+		return o, fmt.Errorf("unknown forest sort field %q", o.SortBy)
+	}
+	if o.PageSize <= 0 {
+		o.PageSize = DefaultForestPageSize
+	}
+	if o.PageSize > MaxForestPageSize {
+		o.PageSize = MaxForestPageSize
+	}
+	return o, nil
+}
+
+// forestSortKey는 정렬 기준에 해당하는 값을 페이지 토큰에 담을 문자열로 바꿉니다.
+func forestSortKey(value any) string {
+	if t, ok := value.(time.Time); ok {
+		return t.UTC().Format(time.RFC3339Nano)
+	}
+	key, _ := value.(string)
+	return key
+}
+
+// forestSortValue는 정렬 기준에 해당하는 숲의 값을 반환합니다.
+func forestSortValue(f *models.Forest, sortBy ForestSort) any {
+	switch sortBy {
+	case ForestSortCreatedAt:
+		return f.CreatedAt
+	case ForestSortUpdatedAt:
+		return f.UpdatedAt
+	default:
+		return f.Name
+	}
+}
+
+func encodeForestCursor(opts ForestListOptions, key string, id string) string {
+	data, _ := json.Marshal(forestCursor{
+		SortBy:     opts.SortBy,
+		Descending: opts.Descending,
+		Key:        key,
+		ID:         id,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeForestCursor는 페이지 토큰을 해석합니다. 토큰이 다른 정렬 조건으로 발급되었으면 ErrInvalidPageToken을 반환합니다.
+func decodeForestCursor(token string, opts ForestListOptions) (*forestCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var cursor forestCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == "" {
+		return nil, ErrInvalidPageToken
+	}
+	if cursor.SortBy != opts.SortBy || cursor.Descending != opts.Descending {
+		return nil, ErrInvalidPageToken
+	}
+	if cursor.SortBy != ForestSortName {
+		if _, err := time.Parse(time.RFC3339Nano, cursor.Key); err != nil {
+			return nil, ErrInvalidPageToken
+		}
+	}
+	return &cursor, nil
+}
+
+func childrenPageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return DefaultChildrenPageSize
+	}
+	return min(pageSize, MaxChildrenPageSize)
+}
+
+// encodeOffsetToken은 자식 목록의 다음 페이지 시작 위치를 페이지 토큰으로 만듭니다.
+func encodeOffsetToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeOffsetToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+	offset, err := strconv.Atoi(string(data))
+	if err != nil || offset < 0 {
+		return 0, ErrInvalidPageToken
+	}
+	return offset, nil
+}
+
+// sortValue는 커서의 정렬 키를 정렬 기준에 맞는 타입(string 또는 time.Time)으로 반환합니다.
+func (c *forestCursor) sortValue() any {
+	if c.SortBy == ForestSortName {
+		return c.Key
+	}
+	t, _ := time.Parse(time.RFC3339Nano, c.Key)
+	return t
+}
+
+// compareForestKeys는 정렬 키와 ID 순으로 두 숲의 위치를 비교합니다.
+func compareForestKeys(aValue any, aID string, bValue any, bID string) int {
+	var c int
+	switch a := aValue.(type) {
+	case time.Time:
+		c = a.Compare(bValue.(time.Time))
+	case string:
+		c = strings.Compare(a, bValue.(string))
+	}
+	if c != 0 {
+		return c
+	}
+	return strings.Compare(aID, bID)
+}
@@ -0,0 +1,211 @@
+package store
+
+import (
+	"cmp"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+const (
+	searchCandidateFactor = 5  // 저장소에서 가져올 후보 수 = 결과 수 × searchCandidateFactor
+	searchSnippetLength   = 80 // 스니펫 최대 길이 (강조 태그 제외, 룬 단위)
+	searchSnippetLead     = 20 // 첫 일치 위치 앞에 보여 줄 길이
+	searchMaxOccurrences  = 3  // 메모 점수에서 term마다 세는 최대 출현 횟수
+)
+
+// 일치한 곳별 가중치 (이름에서 찾은 결과가 가장 앞에 오도록 함)
+var searchSourceWeights = map[models.SearchSource]float64{
+	models.SearchSourceName:    3,
+	models.SearchSourceUrl:     2,
+	models.SearchSourceSummary: 1,
+	models.SearchSourceMemo:    2,
+}
+
+func (o SearchOptions) normalize() (SearchOptions, error) {
+	switch o.Scope {
+	case "":
+		o.Scope = SearchScopeAll
+	case SearchScopeAll, SearchScopeOwned, SearchScopeShared:
+	default:
+		return o, fmt.Errorf("%w: unknown scope %q", ErrInvalidQuery, o.Scope)
+	}
+	if o.Limit <= 0 {
+		o.Limit = DefaultSearchLimit
+	}
+	if o.Limit > MaxSearchLimit {
+		o.Limit = MaxSearchLimit
+	}
+	return o, nil
+}
+
+// toLower는 룬 수가 바뀌지 않도록 룬마다 소문자로 바꿉니다. (스니펫 위치 계산에 사용)
+func toLower(s string) string {
+	return strings.Map(unicode.ToLower, s)
+}
+
+// searchTerms는 검색어를 공백으로 나눈 뒤 소문자로 바꾸고 중복을 없앱니다.
+func searchTerms(query string) []string {
+	var terms []string
+	for _, term := range strings.Fields(toLower(query)) {
+		if !slices.Contains(terms, term) {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// containsTerms는 text에 들어 있는 term 수를 반환합니다.
+func containsTerms(text string, terms []string) int {
+	lower := toLower(text)
+	count := 0
+	for _, term := range terms {
+		if strings.Contains(lower, term) {
+			count++
+		}
+	}
+	return count
+}
+
+// treeSearchHit은 트리의 이름, URL, 요약을 합쳐 모든 term이 들어 있으면 가장 점수가 높은 곳을 일치한 곳으로 하는 결과를 만듭니다.
+// 모든 term이 들어 있지 않으면 nil을 반환합니다.
+func treeSearchHit(tree *models.ForestTree, terms []string) *models.SearchHit {
+	if containsTerms(tree.Tree.Name+"\n"+tree.Tree.Url+"\n"+tree.Tree.Summary, terms) < len(terms) {
+		return nil
+	}
+	hit := &models.SearchHit{ForestId: tree.ForestId, TreeId: tree.Tree.Id, TreeName: tree.Tree.Name}
+	best := 0.0
+	for _, field := range []struct {
+		source models.SearchSource
+		text   string
+	}{
+		{models.SearchSourceName, tree.Tree.Name},
+		{models.SearchSourceUrl, tree.Tree.Url},
+		{models.SearchSourceSummary, tree.Tree.Summary},
+	} {
+		score := searchSourceWeights[field.source] * float64(containsTerms(field.text, terms))
+		hit.Score += score
+		if score > best {
+			best = score
+			hit.Source = field.source
+			hit.Snippet = highlight(field.text, terms)
+		}
+	}
+	return hit
+}
+
+// memoSearchHit은 메모 내용에서 찾은 결과를 만듭니다. 메모는 모든 term을 포함해야 합니다.
+func memoSearchHit(tree *models.ForestTree, content string, terms []string) *models.SearchHit {
+	return &models.SearchHit{
+		ForestId: tree.ForestId,
+		TreeId:   tree.Tree.Id,
+		TreeName: tree.Tree.Name,
+		Source:   models.SearchSourceMemo,
+		Snippet:  highlight(content, terms),
+		Score:    searchSourceWeights[models.SearchSourceMemo] * memoRelevance(content, terms),
+	}
+}
+
+// memoRelevance는 term마다 메모에 나온 횟수(최대 searchMaxOccurrences)를 더하고,
+// 첫 일치가 앞에 있을수록 1에 가까운 값을 더합니다. 모든 term이 한 번씩 늦게 나오면 len(terms)에 가깝습니다.
+func memoRelevance(content string, terms []string) float64 {
+	lower := toLower(content)
+	score := 0.0
+	first := len(lower)
+	for _, term := range terms {
+		score += float64(min(strings.Count(lower, term), searchMaxOccurrences))
+		if i := strings.Index(lower, term); i >= 0 && i < first {
+			first = i
+		}
+	}
+	position := utf8.RuneCountInString(lower[:first])
+	return score + 1/(1+float64(position)/searchSnippetLength)
+}
+
+// highlight는 첫 일치 위치 주변을 잘라 일치한 단어를 <em></em>으로 감쌉니다. 잘린 쪽에는 …을 붙입니다.
+// 스니펫을 HTML로 그리는 클라이언트가 있으므로 강조 태그 밖의 텍스트는 모두 HTML 이스케이프합니다.
+func highlight(text string, terms []string) string {
+	runes := []rune(text)
+	lower := []rune(toLower(text))
+	// 위치마다 그 위치에서 시작하는 가장 긴 일치의 길이
+	matches := make([]int, len(lower))
+	first := -1
+	for i := range lower {
+		for _, term := range terms {
+			termRunes := []rune(term)
+			if len(termRunes) > matches[i] && i+len(termRunes) <= len(lower) && slices.Equal(lower[i:i+len(termRunes)], termRunes) {
+				matches[i] = len(termRunes)
+			}
+		}
+		if matches[i] > 0 && first < 0 {
+			first = i
+		}
+	}
+
+	start := max(0, first-searchSnippetLead)
+	end := min(len(runes), start+searchSnippetLength)
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	for i := start; i < end; {
+		if n := matches[i]; n > 0 {
+			n = min(n, end-i)
+			b.WriteString("<em>" + html.EscapeString(string(runes[i:i+n])) + "</em>")
+			i += n
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[i])))
+		i++
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+// sortSearchHits는 점수 내림차순, 같은 점수면 숲 ID, 트리 ID, 일치한 곳 순으로 정렬합니다.
+func sortSearchHits(hits []*models.SearchHit) {
+	slices.SortFunc(hits, func(a, b *models.SearchHit) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			strings.Compare(a.ForestId, b.ForestId),
+			strings.Compare(a.TreeId, b.TreeId),
+			strings.Compare(string(a.Source), string(b.Source)),
+		)
+	})
+}
+
+// sortUrlMatches는 숲 이름, 숲 ID, 깊이, 트리 ID 순으로 정렬합니다.
+func sortUrlMatches(matches []*models.UrlMatch) {
+	slices.SortFunc(matches, func(a, b *models.UrlMatch) int {
+		return cmp.Or(
+			strings.Compare(a.ForestName, b.ForestName),
+			strings.Compare(a.ForestId, b.ForestId),
+			cmp.Compare(len(a.Path), len(b.Path)),
+			strings.Compare(a.Tree.Id, b.Tree.Id),
+		)
+	})
+}
+
+// escapeLucene은 Lucene 쿼리 문법에서 특수 문자로 쓰이는 문자를 이스케이프합니다.
+func escapeLucene(term string) string {
+	var b strings.Builder
+	for _, r := range term {
+		if strings.ContainsRune(`+-&|!(){}[]^"~*?:\/`, r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// escapeLike는 SQL LIKE 패턴에서 특수 문자로 쓰이는 문자를 이스케이프합니다. (ESCAPE '\')
+func escapeLike(term string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(term)
+}
@@ -0,0 +1,44 @@
+package store
+
+import (
+	"cmp"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+// newShareToken은 추측할 수 없는 링크 토큰과 저장용 해시를 만듭니다.
+func newShareToken() (token string, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(buf)
+	return token, hashShareToken(token), nil
+}
+
+// 토큰 원문 대신 해시를 저장해 저장소가 유출되어도 링크를 사용할 수 없게 함
+func hashShareToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// 생성 시각, ID 순
+func sortShareLinks(links []*models.ShareLink) {
+	slices.SortFunc(links, func(a, b *models.ShareLink) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), strings.Compare(a.Id, b.Id))
+	})
+}
+
+func checkShareLinkExpiry(expiresAt time.Time) error {
+	if !expiresAt.After(time.Now()) {
+		return ErrInvalidShareLink
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+const maxTagLength = 64
+
+// normalizeTags는 태그의 앞뒤 공백을 제거하고 중복을 없앤 뒤 이름 순으로 반환합니다.
+func normalizeTags(tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, ErrInvalidTag
+	}
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag, err := normalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, tag)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+func normalizeTag(tag string) (string, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" || utf8.RuneCountInString(tag) > maxTagLength {
+		return "", ErrInvalidTag
+	}
+	return tag, nil
+}
+
+// addTags와 removeTags는 current를 바꾸지 않고 새 태그 목록을 이름 순으로 반환합니다.
+func addTags(current []string, tags []string) []string {
+	merged := slices.Concat(current, tags)
+	slices.Sort(merged)
+	return slices.Compact(merged)
+}
+
+func removeTags(current []string, tags []string) []string {
+	var remaining []string
+	for _, tag := range current {
+		if !slices.Contains(tags, tag) {
+			remaining = append(remaining, tag)
+		}
+	}
+	return remaining
+}
+
+func sortForestTrees(trees []*models.ForestTree) {
+	slices.SortFunc(trees, func(a, b *models.ForestTree) int {
+		return cmp.Or(strings.Compare(a.Tree.Name, b.Tree.Name), strings.Compare(a.Tree.Id, b.Tree.Id))
+	})
+}
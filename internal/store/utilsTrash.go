@@ -0,0 +1,17 @@
+package store
+
+import (
+	"sort"
+
+	"github.com/jdk829355/InForest_back/models"
+)
+
+// sortTrashItems는 휴지통 항목을 최근에 삭제된 순서로 정렬합니다.
+func sortTrashItems(items []*models.TrashItem) {
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].DeletedAt.Equal(items[j].DeletedAt) {
+			return items[i].DeletedAt.After(items[j].DeletedAt)
+		}
+		return items[i].Id < items[j].Id
+	})
+}
@@ -0,0 +1,182 @@
+package store
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jdk829355/InForest_back/models"
+)
+
+// 유틸함수
+
+// 업데이트할 수 있는 필드 이름 → Neo4j 속성 이름
+var (
+	forestUpdateFields = map[string]string{"name": "name", "description": "description"}
+	treeUpdateFields   = map[string]string{"name": "name", "url": "url"}
+)
+
+// checkUpdate는 업데이트 대상 ID와 필드가 올바른지 확인합니다.
+func checkUpdate(id string, fields []string, allowed map[string]string) error {
+	if id == "" {
+		return fmt.Errorf("%w: missing id", ErrInvalidUpdate)
+	}
+	for _, field := range fields {
+		if _, ok := allowed[field]; !ok {
+			return fmt.Errorf("%w: unknown field %q", ErrInvalidUpdate, field)
+		}
+	}
+	return nil
+}
+
+// cloneTree는 트리를 새 UUID로 깊은 복사하고, 원본 ID → 새 ID 매핑을 idMap에 기록합니다.
+// summary와 태그를 포함한 속성은 그대로 복사되며 자식 순서도 유지됩니다.
+// 방문 기록은 복사하지 않으며, 생성/수정 시각은 now입니다.
+func cloneTree(src *models.Tree, idMap map[string]string, now time.Time) *models.Tree {
+	copied := &models.Tree{
+		Id:           uuid.New().String(),
+		Name:         src.Name,
+		Url:          src.Url,
+		Summary:      src.Summary,
+		CanonicalUrl: src.CanonicalUrl,
+		Tags:         src.Tags,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	idMap[src.Id] = copied.Id
+	for _, child := range src.Children {
+		copied.Children = append(copied.Children, cloneTree(child, idMap, now))
+	}
+	return copied
+}
+
+// stampTree는 하위 트리 전체의 생성/수정 시각을 now로 정하고 트리 수와 깊이(루트 = 1)를 반환합니다.
+func stampTree(root *models.Tree, now time.Time) (total int32, depth int32) {
+	root.CreatedAt = now
+	root.UpdatedAt = now
+	total = 1
+	for _, child := range root.Children {
+		childTotal, childDepth := stampTree(child, now)
+		total += childTotal
+		depth = max(depth, childDepth)
+	}
+	return total, depth + 1
+}
+
+// checkImportVisits는 가져올 방문 기록이 모두 root의 하위 트리를 가리키고 머문 시간이 올바른지 확인합니다.
+func checkImportVisits(root *models.Tree, visits []*models.Visit) error {
+	ids := map[string]bool{}
+	var walk func(t *models.Tree)
+	walk = func(t *models.Tree) {
+		ids[t.Id] = true
+		for _, child := range t.Children {
+			walk(child)
+		}
+	}
+	walk(root)
+	for _, visit := range visits {
+		if visit.DwellMs < 0 {
+			return ErrInvalidVisit
+		}
+		if !ids[visit.TreeId] {
+			return ErrTreeNotFound
+		}
+	}
+	return nil
+}
+
+// subtreeDepth는 조회 옵션을 포함할 하위 트리 깊이로 바꿉니다. 음수는 제한 없음을 뜻합니다.
+func subtreeDepth(includeChildren bool, maxDepth int) int {
+	if !includeChildren {
+		return 0
+	}
+	if maxDepth <= 0 {
+		return -1
+	}
+	return maxDepth
+}
+
+// insertChild는 childID를 index번째 (휴지통에 없는) 자식 앞에 끼워 넣은 형제 순서를 반환합니다.
+// index가 음수이거나 자식 수 이상이면 맨 뒤에 추가합니다.
+func insertChild(children []string, liveChildren []string, childID string, index int) []string {
+	if index < 0 || index >= len(liveChildren) {
+		return append(children, childID)
+	}
+	ordered := make([]string, 0, len(children)+1)
+	for _, id := range children {
+		if id == liveChildren[index] {
+			ordered = append(ordered, childID)
+		}
+		ordered = append(ordered, id)
+	}
+	return ordered
+}
+
+// reorderChildren은 휴지통에 없는 자식들을 childIDs 순서로 바꾼 형제 순서를 반환합니다.
+// 휴지통에 있는 자식은 원래 자리를 유지합니다. childIDs는 휴지통에 없는 자식을 정확히 한 번씩 포함해야 합니다.
+func reorderChildren(children []string, liveChildren []string, childIDs []string) ([]string, error) {
+	if len(childIDs) != len(liveChildren) {
+		return nil, ErrInvalidChildOrder
+	}
+	live := make(map[string]bool, len(liveChildren))
+	for _, id := range liveChildren {
+		live[id] = true
+	}
+	seen := make(map[string]bool, len(childIDs))
+	for _, id := range childIDs {
+		if !live[id] || seen[id] {
+			return nil, ErrInvalidChildOrder
+		}
+		seen[id] = true
+	}
+
+	ordered := make([]string, 0, len(children))
+	next := 0
+	for _, id := range children {
+		if live[id] {
+			ordered = append(ordered, childIDs[next])
+			next++
+			continue
+		}
+		ordered = append(ordered, id)
+	}
+	return ordered, nil
+}
+
+// replaceSibling은 형제 순서에서 target 자리를 replacement로 바꾼 순서를 반환합니다.
+func replaceSibling(children []string, target string, replacement []string) []string {
+	replaced := make([]string, 0, len(children)+len(replacement))
+	for _, id := range children {
+		if id == target {
+			replaced = append(replaced, replacement...)
+			continue
+		}
+		replaced = append(replaced, id)
+	}
+	return replaced
+}
+
+// collapseSiblings는 replaceSibling을 되돌립니다.
+// collapsed 중 처음 나오는 자리에 target을 두고 나머지 collapsed는 뺀 순서를 반환하며,
+// collapsed가 하나도 없으면 target의 원래 자리를 유지합니다.
+func collapseSiblings(children []string, target string, collapsed []string) []string {
+	placeAt := target
+	for _, id := range children {
+		if slices.Contains(collapsed, id) {
+			placeAt = id
+			break
+		}
+	}
+	result := make([]string, 0, len(children))
+	for _, id := range children {
+		switch {
+		case id == placeAt:
+			result = append(result, target)
+		case id == target, slices.Contains(collapsed, id):
+		default:
+			result = append(result, id)
+		}
+	}
+	return result
+}
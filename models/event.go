@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jdk829355/InForest_back/protos/forest"
+)
+
+// ForestEventType은 숲 변경 이벤트의 종류입니다.
+type ForestEventType string
+
+const (
+	ForestEventTreeAdded         ForestEventType = "tree_added"
+	ForestEventTreeUpdated       ForestEventType = "tree_updated"
+	ForestEventTreeMoved         ForestEventType = "tree_moved"
+	ForestEventTreeDeleted       ForestEventType = "tree_deleted"
+	ForestEventChildrenReordered ForestEventType = "children_reordered"
+	ForestEventForestUpdated     ForestEventType = "forest_updated"
+	ForestEventForestDeleted     ForestEventType = "forest_deleted"
+	ForestEventSummaryCompleted  ForestEventType = "summary_completed"
+	ForestEventMembersChanged    ForestEventType = "members_changed"
+)
+
+var forestEventTypeProtos = map[ForestEventType]forest.ForestEventType{
+	ForestEventTreeAdded:         forest.ForestEventType_FOREST_EVENT_TYPE_TREE_ADDED,
+	ForestEventTreeUpdated:       forest.ForestEventType_FOREST_EVENT_TYPE_TREE_UPDATED,
+	ForestEventTreeMoved:         forest.ForestEventType_FOREST_EVENT_TYPE_TREE_MOVED,
+	ForestEventTreeDeleted:       forest.ForestEventType_FOREST_EVENT_TYPE_TREE_DELETED,
+	ForestEventChildrenReordered: forest.ForestEventType_FOREST_EVENT_TYPE_CHILDREN_REORDERED,
+	ForestEventForestUpdated:     forest.ForestEventType_FOREST_EVENT_TYPE_FOREST_UPDATED,
+	ForestEventForestDeleted:     forest.ForestEventType_FOREST_EVENT_TYPE_FOREST_DELETED,
+	ForestEventSummaryCompleted:  forest.ForestEventType_FOREST_EVENT_TYPE_SUMMARY_COMPLETED,
+	ForestEventMembersChanged:    forest.ForestEventType_FOREST_EVENT_TYPE_MEMBERS_CHANGED,
+}
+
+// ForestEvent는 WatchForest로 전달되는 숲 변경 이벤트입니다.
+// 여러 서버 간에 JSON으로 전달되므로 모든 필드를 직렬화할 수 있어야 합니다.
+type ForestEvent struct {
+	Type       ForestEventType `json:"type"`
+	ForestId   string          `json:"forest_id"`
+	TreeId     string          `json:"tree_id,omitempty"`
+	ParentId   string          `json:"parent_id,omitempty"`
+	Tree       *Tree           `json:"tree,omitempty"`
+	Forest     *Forest         `json:"forest,omitempty"`
+	UserId     string          `json:"user_id,omitempty"`
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+func (e *ForestEvent) ToProto() *forest.ForestEvent {
+	event := &forest.ForestEvent{
+		Type:       forestEventTypeProtos[e.Type],
+		ForestId:   e.ForestId,
+		TreeId:     e.TreeId,
+		ParentId:   e.ParentId,
+		UserId:     e.UserId,
+		OccurredAt: timestampProto(e.OccurredAt),
+	}
+	if e.Tree != nil {
+		event.Tree = e.Tree.ToProto()
+	}
+	if e.Forest != nil {
+		event.Forest = e.Forest.ToProto()
+	}
+	return event
+}
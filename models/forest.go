@@ -1,25 +1,53 @@
 package models
 
 import (
+	"time"
+
 	gen "github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Forest struct {
-	UserId      string `json:"user_id"`
-	Id          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Depth       int32  `json:"depth"`
-	TotalTrees  int32  `json:"total_trees"`
-	Root        *Tree  `json:"root"`
+	UserId      string   `json:"user_id"`
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Depth       int32    `json:"depth"`
+	TotalTrees  int32    `json:"total_trees"`
+	Root        *Tree    `json:"root"`
+	Tags        []string `json:"tags"` // 이름 순
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"` // 숲 정보나 트리 구조가 마지막으로 바뀐 시각
 }
 
 type Tree struct {
-	Id       string  `json:"id"`
-	Name     string  `json:"name"`
-	Url      string  `json:"url"`
-	Children []*Tree `json:"children"`
-	Summary  string  `json:"summary"`
+	Id       string   `json:"id"`
+	Name     string   `json:"name"`
+	Url      string   `json:"url"`
+	Children []*Tree  `json:"children"`
+	Summary  string   `json:"summary"`
+	Tags     []string `json:"tags"` // 이름 순
+
+	CanonicalUrl string `json:"canonical_url"` // 정규화한 url (같은 페이지인지 비교할 때 사용)
+
+	// 방문 기록 집계 (방문한 적이 없으면 0 값)
+	FirstVisitedAt time.Time `json:"first_visited_at"`
+	LastVisitedAt  time.Time `json:"last_visited_at"`
+	VisitCount     int32     `json:"visit_count"`
+	TotalDwellMs   int64     `json:"total_dwell_ms"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"` // 이름, URL, 태그가 마지막으로 바뀐 시각
+
+	ChildCount int32 `json:"child_count"` // 휴지통에 없는 직계 자식 수 (ListChildren에서만 채워짐)
+}
+
+// TreeNode는 스트리밍으로 보내는 트리 하나입니다. Tree.Children은 비어 있습니다.
+type TreeNode struct {
+	Tree     Tree   `json:"tree"`
+	ParentId string `json:"parent_id"` // 루트 트리면 ""
+	Depth    int32  `json:"depth"`     // 루트 트리 = 0
 }
 
 func (f *Forest) ToProto() *gen.Forest {
@@ -34,6 +62,17 @@ func (f *Forest) ToProto() *gen.Forest {
 		Depth:       f.Depth,
 		TotalTrees:  f.TotalTrees,
 		Root:        f.Root.ToProto(),
+		Tags:        f.Tags,
+		CreatedAt:   timestampProto(f.CreatedAt),
+		UpdatedAt:   timestampProto(f.UpdatedAt),
+	}
+}
+
+func (n *TreeNode) ToProto() *gen.TreeNode {
+	return &gen.TreeNode{
+		Tree:     n.Tree.ToProto(),
+		ParentId: n.ParentId,
+		Depth:    n.Depth,
 	}
 }
 
@@ -46,10 +85,69 @@ func (t *Tree) ToProto() *gen.Tree {
 		children[i] = child.ToProto()
 	}
 	return &gen.Tree{
-		Id:       t.Id,
-		Name:     t.Name,
-		Url:      t.Url,
-		Children: children,
-		Summary:  t.Summary,
+		Id:             t.Id,
+		Name:           t.Name,
+		Url:            t.Url,
+		Children:       children,
+		Summary:        t.Summary,
+		ChildCount:     t.ChildCount,
+		CanonicalUrl:   t.CanonicalUrl,
+		HasChildren:    t.ChildCount > 0,
+		Tags:           t.Tags,
+		FirstVisitedAt: timestampProto(t.FirstVisitedAt),
+		LastVisitedAt:  timestampProto(t.LastVisitedAt),
+		VisitCount:     t.VisitCount,
+		TotalDwellMs:   t.TotalDwellMs,
+		CreatedAt:      timestampProto(t.CreatedAt),
+		UpdatedAt:      timestampProto(t.UpdatedAt),
+	}
+}
+
+// timestampProto는 시각을 proto Timestamp로 바꿉니다. 0 값이면 nil을 반환합니다.
+func timestampProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// ForestTree는 트리와 트리가 속한 숲 ID입니다. 검색 결과에 사용하며 Tree.Children은 비어 있습니다.
+type ForestTree struct {
+	Tree     Tree   `json:"tree"`
+	ForestId string `json:"forest_id"`
+}
+
+func (t *ForestTree) ToProto() *gen.TaggedTree {
+	return &gen.TaggedTree{
+		Tree:     t.Tree.ToProto(),
+		ForestId: t.ForestId,
+	}
+}
+
+// TreeRef는 경로에 포함된 트리의 ID와 이름입니다.
+type TreeRef struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// UrlMatch는 URL이 일치하는 트리와 그 트리의 위치입니다. Tree.Children은 비어 있습니다.
+type UrlMatch struct {
+	Tree       Tree      `json:"tree"`
+	ForestId   string    `json:"forest_id"`
+	ForestName string    `json:"forest_name"`
+	Path       []TreeRef `json:"path"` // 루트부터 트리 자신까지
+}
+
+func (m *UrlMatch) ToProto() *gen.UrlMatch {
+	path := make([]*gen.TreeRef, len(m.Path))
+	for i, ref := range m.Path {
+		path[i] = &gen.TreeRef{Id: ref.Id, Name: ref.Name}
+	}
+	return &gen.UrlMatch{
+		Tree:       m.Tree.ToProto(),
+		ForestId:   m.ForestId,
+		ForestName: m.ForestName,
+		Path:       path,
+		HasSummary: m.Tree.Summary != "",
 	}
 }
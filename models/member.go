@@ -0,0 +1,58 @@
+package models
+
+import (
+	"github.com/jdk829355/InForest_back/protos/forest"
+)
+
+// ForestRole은 숲에 대한 사용자의 권한입니다. 위 역할은 아래 역할의 권한을 모두 가집니다.
+type ForestRole string
+
+const (
+	ForestRoleViewer ForestRole = "viewer"
+	ForestRoleEditor ForestRole = "editor"
+	ForestRoleOwner  ForestRole = "owner"
+)
+
+var forestRoleRanks = map[ForestRole]int{
+	ForestRoleViewer: 1,
+	ForestRoleEditor: 2,
+	ForestRoleOwner:  3,
+}
+
+// Allows는 r이 required 역할의 권한을 가지는지 반환합니다. 역할이 없으면("") false입니다.
+func (r ForestRole) Allows(required ForestRole) bool {
+	return r != "" && forestRoleRanks[r] >= forestRoleRanks[required]
+}
+
+var forestRoleProtos = map[ForestRole]forest.ForestRole{
+	ForestRoleViewer: forest.ForestRole_FOREST_ROLE_VIEWER,
+	ForestRoleEditor: forest.ForestRole_FOREST_ROLE_EDITOR,
+	ForestRoleOwner:  forest.ForestRole_FOREST_ROLE_OWNER,
+}
+
+func (r ForestRole) ToProto() forest.ForestRole {
+	return forestRoleProtos[r]
+}
+
+// ForestRoleFromProto는 proto 역할을 변환합니다. 알 수 없는 값이면 ""를 반환합니다.
+func ForestRoleFromProto(role forest.ForestRole) ForestRole {
+	for r, p := range forestRoleProtos {
+		if p == role {
+			return r
+		}
+	}
+	return ""
+}
+
+// ForestMember는 숲을 공유받은 사용자입니다.
+type ForestMember struct {
+	UserId string     `json:"user_id"`
+	Role   ForestRole `json:"role"`
+}
+
+func (m *ForestMember) ToProto() *forest.ForestMember {
+	return &forest.ForestMember{
+		UserId: m.UserId,
+		Role:   m.Role.ToProto(),
+	}
+}
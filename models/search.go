@@ -0,0 +1,47 @@
+package models
+
+import (
+	"github.com/jdk829355/InForest_back/protos/forest"
+)
+
+// SearchSource는 검색어가 일치한 곳입니다.
+type SearchSource string
+
+const (
+	SearchSourceName    SearchSource = "name"
+	SearchSourceUrl     SearchSource = "url"
+	SearchSourceSummary SearchSource = "summary"
+	SearchSourceMemo    SearchSource = "memo"
+)
+
+var searchSourceProtos = map[SearchSource]forest.MatchSource{
+	SearchSourceName:    forest.MatchSource_MATCH_SOURCE_NAME,
+	SearchSourceUrl:     forest.MatchSource_MATCH_SOURCE_URL,
+	SearchSourceSummary: forest.MatchSource_MATCH_SOURCE_SUMMARY,
+	SearchSourceMemo:    forest.MatchSource_MATCH_SOURCE_MEMO,
+}
+
+func (s SearchSource) ToProto() forest.MatchSource {
+	return searchSourceProtos[s]
+}
+
+// SearchHit은 검색 결과 하나입니다. Snippet에서 일치한 단어는 <em></em>으로 감쌉니다.
+type SearchHit struct {
+	ForestId string       `json:"forest_id"`
+	TreeId   string       `json:"tree_id"`
+	TreeName string       `json:"tree_name"`
+	Source   SearchSource `json:"source"`
+	Snippet  string       `json:"snippet"`
+	Score    float64      `json:"score"`
+}
+
+func (h *SearchHit) ToProto() *forest.SearchHit {
+	return &forest.SearchHit{
+		ForestId: h.ForestId,
+		TreeId:   h.TreeId,
+		TreeName: h.TreeName,
+		Source:   h.Source.ToProto(),
+		Snippet:  h.Snippet,
+		Score:    h.Score,
+	}
+}
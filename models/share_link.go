@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jdk829355/InForest_back/protos/forest"
+)
+
+// ShareLink는 계정 없이 숲을 읽기 전용으로 볼 수 있는 공개 링크입니다.
+// 토큰 원문은 저장하지 않으며 생성할 때만 Token에 담겨 반환됩니다.
+type ShareLink struct {
+	Id        string    `json:"id"`
+	ForestId  string    `json:"forest_id"`
+	Token     string    `json:"token,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (l *ShareLink) ToProto() *forest.ShareLink {
+	return &forest.ShareLink{
+		Id:        l.Id,
+		ForestId:  l.ForestId,
+		Token:     l.Token,
+		ExpiresAt: timestampProto(l.ExpiresAt),
+		CreatedAt: timestampProto(l.CreatedAt),
+	}
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"github.com/jdk829355/InForest_back/protos/forest"
+)
+
+// TagCount는 태그와 그 태그가 붙은 숲, 트리 수입니다. 휴지통에 있는 항목은 세지 않습니다.
+type TagCount struct {
+	Name        string `json:"name"`
+	TreeCount   int32  `json:"tree_count"`
+	ForestCount int32  `json:"forest_count"`
+}
+
+func (c *TagCount) ToProto() *forest.TagCount {
+	return &forest.TagCount{
+		Name:        c.Name,
+		TreeCount:   c.TreeCount,
+		ForestCount: c.ForestCount,
+	}
+}
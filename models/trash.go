@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jdk829355/InForest_back/protos/forest"
+)
+
+const (
+	TrashItemForest = "forest"
+	TrashItemTree   = "tree"
+)
+
+// TrashItem은 휴지통에 있는 숲 또는 트리입니다.
+type TrashItem struct {
+	Type      string    `json:"type"` // TrashItemForest 또는 TrashItemTree
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	ForestId  string    `json:"forest_id"`
+	UserId    string    `json:"user_id"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+func (t *TrashItem) ToProto() *forest.TrashItem {
+	return &forest.TrashItem{
+		Type:      t.Type,
+		Id:        t.Id,
+		Name:      t.Name,
+		ForestId:  t.ForestId,
+		DeletedAt: timestampProto(t.DeletedAt),
+	}
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// Visit는 사용자가 트리 페이지를 한 번 방문한 기록입니다.
+type Visit struct {
+	Id        string    `json:"id"`
+	TreeId    string    `json:"tree_id"`
+	UserId    string    `json:"user_id"`
+	VisitedAt time.Time `json:"visited_at"`
+	DwellMs   int64     `json:"dwell_ms"` // 페이지에 머문 시간
+}
@@ -9,6 +9,8 @@ package forest
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,28 +23,3649 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type GetSummaryRequest struct {
+type ForestEventType int32
+
+const (
+	ForestEventType_FOREST_EVENT_TYPE_UNSPECIFIED        ForestEventType = 0
+	ForestEventType_FOREST_EVENT_TYPE_TREE_ADDED         ForestEventType = 1 // 생성, 복사, 복원 포함
+	ForestEventType_FOREST_EVENT_TYPE_TREE_UPDATED       ForestEventType = 2
+	ForestEventType_FOREST_EVENT_TYPE_TREE_MOVED         ForestEventType = 3
+	ForestEventType_FOREST_EVENT_TYPE_TREE_DELETED       ForestEventType = 4
+	ForestEventType_FOREST_EVENT_TYPE_CHILDREN_REORDERED ForestEventType = 5
+	ForestEventType_FOREST_EVENT_TYPE_FOREST_UPDATED     ForestEventType = 6 // 이름 또는 설명 변경
+	ForestEventType_FOREST_EVENT_TYPE_FOREST_DELETED     ForestEventType = 7
+	ForestEventType_FOREST_EVENT_TYPE_SUMMARY_COMPLETED  ForestEventType = 8
+	ForestEventType_FOREST_EVENT_TYPE_MEMBERS_CHANGED    ForestEventType = 9 // 공유 추가, 역할 변경, 공유 해제
+)
+
+// Enum value maps for ForestEventType.
+var (
+	ForestEventType_name = map[int32]string{
+		0: "FOREST_EVENT_TYPE_UNSPECIFIED",
+		1: "FOREST_EVENT_TYPE_TREE_ADDED",
+		2: "FOREST_EVENT_TYPE_TREE_UPDATED",
+		3: "FOREST_EVENT_TYPE_TREE_MOVED",
+		4: "FOREST_EVENT_TYPE_TREE_DELETED",
+		5: "FOREST_EVENT_TYPE_CHILDREN_REORDERED",
+		6: "FOREST_EVENT_TYPE_FOREST_UPDATED",
+		7: "FOREST_EVENT_TYPE_FOREST_DELETED",
+		8: "FOREST_EVENT_TYPE_SUMMARY_COMPLETED",
+		9: "FOREST_EVENT_TYPE_MEMBERS_CHANGED",
+	}
+	ForestEventType_value = map[string]int32{
+		"FOREST_EVENT_TYPE_UNSPECIFIED":        0,
+		"FOREST_EVENT_TYPE_TREE_ADDED":         1,
+		"FOREST_EVENT_TYPE_TREE_UPDATED":       2,
+		"FOREST_EVENT_TYPE_TREE_MOVED":         3,
+		"FOREST_EVENT_TYPE_TREE_DELETED":       4,
+		"FOREST_EVENT_TYPE_CHILDREN_REORDERED": 5,
+		"FOREST_EVENT_TYPE_FOREST_UPDATED":     6,
+		"FOREST_EVENT_TYPE_FOREST_DELETED":     7,
+		"FOREST_EVENT_TYPE_SUMMARY_COMPLETED":  8,
+		"FOREST_EVENT_TYPE_MEMBERS_CHANGED":    9,
+	}
+)
+
+func (x ForestEventType) Enum() *ForestEventType {
+	p := new(ForestEventType)
+	*p = x
+	return p
+}
+
+func (x ForestEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForestEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_forest_forest_proto_enumTypes[0].Descriptor()
+}
+
+func (ForestEventType) Type() protoreflect.EnumType {
+	return &file_protos_forest_forest_proto_enumTypes[0]
+}
+
+func (x ForestEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForestEventType.Descriptor instead.
+func (ForestEventType) EnumDescriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{0}
+}
+
+type ForestSortField int32
+
+const (
+	ForestSortField_FOREST_SORT_FIELD_UNSPECIFIED ForestSortField = 0 // 이름 순
+	ForestSortField_FOREST_SORT_FIELD_NAME        ForestSortField = 1
+	ForestSortField_FOREST_SORT_FIELD_CREATED_AT  ForestSortField = 2
+	ForestSortField_FOREST_SORT_FIELD_UPDATED_AT  ForestSortField = 3
+)
+
+// Enum value maps for ForestSortField.
+var (
+	ForestSortField_name = map[int32]string{
+		0: "FOREST_SORT_FIELD_UNSPECIFIED",
+		1: "FOREST_SORT_FIELD_NAME",
+		2: "FOREST_SORT_FIELD_CREATED_AT",
+		3: "FOREST_SORT_FIELD_UPDATED_AT",
+	}
+	ForestSortField_value = map[string]int32{
+		"FOREST_SORT_FIELD_UNSPECIFIED": 0,
+		"FOREST_SORT_FIELD_NAME":        1,
+		"FOREST_SORT_FIELD_CREATED_AT":  2,
+		"FOREST_SORT_FIELD_UPDATED_AT":  3,
+	}
+)
+
+func (x ForestSortField) Enum() *ForestSortField {
+	p := new(ForestSortField)
+	*p = x
+	return p
+}
+
+func (x ForestSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForestSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_forest_forest_proto_enumTypes[1].Descriptor()
+}
+
+func (ForestSortField) Type() protoreflect.EnumType {
+	return &file_protos_forest_forest_proto_enumTypes[1]
+}
+
+func (x ForestSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForestSortField.Descriptor instead.
+func (ForestSortField) EnumDescriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{1}
+}
+
+// 같은 숲에 정규화한 URL이 같은 트리가 있을 때의 처리
+type DuplicatePolicy int32
+
+const (
+	DuplicatePolicy_DUPLICATE_POLICY_ALLOW  DuplicatePolicy = 0 // 중복 트리 생성
+	DuplicatePolicy_DUPLICATE_POLICY_REJECT DuplicatePolicy = 1 // AlreadyExists 반환
+	DuplicatePolicy_DUPLICATE_POLICY_MERGE  DuplicatePolicy = 2 // 새로 만들지 않고 기존 트리 반환
+)
+
+// Enum value maps for DuplicatePolicy.
+var (
+	DuplicatePolicy_name = map[int32]string{
+		0: "DUPLICATE_POLICY_ALLOW",
+		1: "DUPLICATE_POLICY_REJECT",
+		2: "DUPLICATE_POLICY_MERGE",
+	}
+	DuplicatePolicy_value = map[string]int32{
+		"DUPLICATE_POLICY_ALLOW":  0,
+		"DUPLICATE_POLICY_REJECT": 1,
+		"DUPLICATE_POLICY_MERGE":  2,
+	}
+)
+
+func (x DuplicatePolicy) Enum() *DuplicatePolicy {
+	p := new(DuplicatePolicy)
+	*p = x
+	return p
+}
+
+func (x DuplicatePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DuplicatePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_forest_forest_proto_enumTypes[2].Descriptor()
+}
+
+func (DuplicatePolicy) Type() protoreflect.EnumType {
+	return &file_protos_forest_forest_proto_enumTypes[2]
+}
+
+func (x DuplicatePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DuplicatePolicy.Descriptor instead.
+func (DuplicatePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{2}
+}
+
+// 숲 공유 관련 RPC
+// viewer는 조회만, editor는 트리와 메모 편집까지 가능하며 숲 삭제와 공유는 소유자만 가능
+type ForestRole int32
+
+const (
+	ForestRole_FOREST_ROLE_UNSPECIFIED ForestRole = 0
+	ForestRole_FOREST_ROLE_VIEWER      ForestRole = 1
+	ForestRole_FOREST_ROLE_EDITOR      ForestRole = 2
+	ForestRole_FOREST_ROLE_OWNER       ForestRole = 3
+)
+
+// Enum value maps for ForestRole.
+var (
+	ForestRole_name = map[int32]string{
+		0: "FOREST_ROLE_UNSPECIFIED",
+		1: "FOREST_ROLE_VIEWER",
+		2: "FOREST_ROLE_EDITOR",
+		3: "FOREST_ROLE_OWNER",
+	}
+	ForestRole_value = map[string]int32{
+		"FOREST_ROLE_UNSPECIFIED": 0,
+		"FOREST_ROLE_VIEWER":      1,
+		"FOREST_ROLE_EDITOR":      2,
+		"FOREST_ROLE_OWNER":       3,
+	}
+)
+
+func (x ForestRole) Enum() *ForestRole {
+	p := new(ForestRole)
+	*p = x
+	return p
+}
+
+func (x ForestRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForestRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_forest_forest_proto_enumTypes[3].Descriptor()
+}
+
+func (ForestRole) Type() protoreflect.EnumType {
+	return &file_protos_forest_forest_proto_enumTypes[3]
+}
+
+func (x ForestRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForestRole.Descriptor instead.
+func (ForestRole) EnumDescriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{3}
+}
+
+// 검색 관련 RPC
+// 트리 이름, URL, 요약과 메모 내용에서 검색어의 모든 단어를 대소문자 구분 없이 찾음
+type SearchScope int32
+
+const (
+	SearchScope_SEARCH_SCOPE_ALL    SearchScope = 0 // 소유한 숲과 공유받은 숲
+	SearchScope_SEARCH_SCOPE_OWNED  SearchScope = 1
+	SearchScope_SEARCH_SCOPE_SHARED SearchScope = 2
+)
+
+// Enum value maps for SearchScope.
+var (
+	SearchScope_name = map[int32]string{
+		0: "SEARCH_SCOPE_ALL",
+		1: "SEARCH_SCOPE_OWNED",
+		2: "SEARCH_SCOPE_SHARED",
+	}
+	SearchScope_value = map[string]int32{
+		"SEARCH_SCOPE_ALL":    0,
+		"SEARCH_SCOPE_OWNED":  1,
+		"SEARCH_SCOPE_SHARED": 2,
+	}
+)
+
+func (x SearchScope) Enum() *SearchScope {
+	p := new(SearchScope)
+	*p = x
+	return p
+}
+
+func (x SearchScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_forest_forest_proto_enumTypes[4].Descriptor()
+}
+
+func (SearchScope) Type() protoreflect.EnumType {
+	return &file_protos_forest_forest_proto_enumTypes[4]
+}
+
+func (x SearchScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchScope.Descriptor instead.
+func (SearchScope) EnumDescriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{4}
+}
+
+type MatchSource int32
+
+const (
+	MatchSource_MATCH_SOURCE_UNSPECIFIED MatchSource = 0
+	MatchSource_MATCH_SOURCE_NAME        MatchSource = 1
+	MatchSource_MATCH_SOURCE_URL         MatchSource = 2
+	MatchSource_MATCH_SOURCE_SUMMARY     MatchSource = 3
+	MatchSource_MATCH_SOURCE_MEMO        MatchSource = 4
+)
+
+// Enum value maps for MatchSource.
+var (
+	MatchSource_name = map[int32]string{
+		0: "MATCH_SOURCE_UNSPECIFIED",
+		1: "MATCH_SOURCE_NAME",
+		2: "MATCH_SOURCE_URL",
+		3: "MATCH_SOURCE_SUMMARY",
+		4: "MATCH_SOURCE_MEMO",
+	}
+	MatchSource_value = map[string]int32{
+		"MATCH_SOURCE_UNSPECIFIED": 0,
+		"MATCH_SOURCE_NAME":        1,
+		"MATCH_SOURCE_URL":         2,
+		"MATCH_SOURCE_SUMMARY":     3,
+		"MATCH_SOURCE_MEMO":        4,
+	}
+)
+
+func (x MatchSource) Enum() *MatchSource {
+	p := new(MatchSource)
+	*p = x
+	return p
+}
+
+func (x MatchSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_forest_forest_proto_enumTypes[5].Descriptor()
+}
+
+func (MatchSource) Type() protoreflect.EnumType {
+	return &file_protos_forest_forest_proto_enumTypes[5]
+}
+
+func (x MatchSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchSource.Descriptor instead.
+func (MatchSource) EnumDescriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{5}
+}
+
+// 브라우저 방문 기록을 가져와 세션마다 숲을 만드는 RPC
+// 첫 메시지로 options를 보낼 수 있으며, 이후에는 record만 보냄
+type HistoryTransition int32
+
+const (
+	HistoryTransition_HISTORY_TRANSITION_UNSPECIFIED   HistoryTransition = 0 // link와 같이 처리
+	HistoryTransition_HISTORY_TRANSITION_LINK          HistoryTransition = 1
+	HistoryTransition_HISTORY_TRANSITION_TYPED         HistoryTransition = 2 // referrer가 있어도 새 시작 트리가 됨
+	HistoryTransition_HISTORY_TRANSITION_AUTO_BOOKMARK HistoryTransition = 3 // referrer가 있어도 새 시작 트리가 됨
+	HistoryTransition_HISTORY_TRANSITION_GENERATED     HistoryTransition = 4 // referrer가 있어도 새 시작 트리가 됨
+	HistoryTransition_HISTORY_TRANSITION_FORM_SUBMIT   HistoryTransition = 5
+	HistoryTransition_HISTORY_TRANSITION_RELOAD        HistoryTransition = 6
+	HistoryTransition_HISTORY_TRANSITION_SUBFRAME      HistoryTransition = 7 // 가져오지 않음
+)
+
+// Enum value maps for HistoryTransition.
+var (
+	HistoryTransition_name = map[int32]string{
+		0: "HISTORY_TRANSITION_UNSPECIFIED",
+		1: "HISTORY_TRANSITION_LINK",
+		2: "HISTORY_TRANSITION_TYPED",
+		3: "HISTORY_TRANSITION_AUTO_BOOKMARK",
+		4: "HISTORY_TRANSITION_GENERATED",
+		5: "HISTORY_TRANSITION_FORM_SUBMIT",
+		6: "HISTORY_TRANSITION_RELOAD",
+		7: "HISTORY_TRANSITION_SUBFRAME",
+	}
+	HistoryTransition_value = map[string]int32{
+		"HISTORY_TRANSITION_UNSPECIFIED":   0,
+		"HISTORY_TRANSITION_LINK":          1,
+		"HISTORY_TRANSITION_TYPED":         2,
+		"HISTORY_TRANSITION_AUTO_BOOKMARK": 3,
+		"HISTORY_TRANSITION_GENERATED":     4,
+		"HISTORY_TRANSITION_FORM_SUBMIT":   5,
+		"HISTORY_TRANSITION_RELOAD":        6,
+		"HISTORY_TRANSITION_SUBFRAME":      7,
+	}
+)
+
+func (x HistoryTransition) Enum() *HistoryTransition {
+	p := new(HistoryTransition)
+	*p = x
+	return p
+}
+
+func (x HistoryTransition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HistoryTransition) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_forest_forest_proto_enumTypes[6].Descriptor()
+}
+
+func (HistoryTransition) Type() protoreflect.EnumType {
+	return &file_protos_forest_forest_proto_enumTypes[6]
+}
+
+func (x HistoryTransition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HistoryTransition.Descriptor instead.
+func (HistoryTransition) EnumDescriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{6}
+}
+
+// 숲 전체를 파일 형식으로 내보내는 RPC
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_JSON          ExportFormat = 0 // 버전이 붙은 고정 스키마
+	ExportFormat_EXPORT_FORMAT_MARKDOWN      ExportFormat = 1 // 요약(과 메모)을 포함한 중첩 목록
+	ExportFormat_EXPORT_FORMAT_OPML          ExportFormat = 2
+	ExportFormat_EXPORT_FORMAT_NETSCAPE_HTML ExportFormat = 3 // 브라우저에서 가져올 수 있는 북마크 파일
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_JSON",
+		1: "EXPORT_FORMAT_MARKDOWN",
+		2: "EXPORT_FORMAT_OPML",
+		3: "EXPORT_FORMAT_NETSCAPE_HTML",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_JSON":          0,
+		"EXPORT_FORMAT_MARKDOWN":      1,
+		"EXPORT_FORMAT_OPML":          2,
+		"EXPORT_FORMAT_NETSCAPE_HTML": 3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_forest_forest_proto_enumTypes[7].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_protos_forest_forest_proto_enumTypes[7]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{7}
+}
+
+// 숲의 트리를 너비 우선으로 나눠 보내는 RPC
+type StreamForestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	ChunkSize     int32                  `protobuf:"varint,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"` // 청크당 트리 수, 0이면 기본값(200), 최대 1000
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSummaryRequest) Reset() {
-	*x = GetSummaryRequest{}
+func (x *StreamForestRequest) Reset() {
+	*x = StreamForestRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamForestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamForestRequest) ProtoMessage() {}
+
+func (x *StreamForestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_forest_forest_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamForestRequest.ProtoReflect.Descriptor instead.
+func (*StreamForestRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreamForestRequest) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+func (x *StreamForestRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+type TreeNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tree          *Tree                  `protobuf:"bytes,1,opt,name=tree,proto3" json:"tree,omitempty"`                         // children은 비어 있음
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 루트 트리면 ""
+	Depth         int32                  `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`                      // 루트 트리 = 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreeNode) Reset() {
+	*x = TreeNode{}
+	mi := &file_protos_forest_forest_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreeNode) ProtoMessage() {}
+
+func (x *TreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreeNode.ProtoReflect.Descriptor instead.
+func (*TreeNode) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TreeNode) GetTree() *Tree {
+	if x != nil {
+		return x.Tree
+	}
+	return nil
+}
+
+func (x *TreeNode) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *TreeNode) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+type StreamForestChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Forest        *Forest                `protobuf:"bytes,1,opt,name=forest,proto3" json:"forest,omitempty"` // 첫 번째 청크에만 포함 (root 제외)
+	Nodes         []*TreeNode            `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamForestChunk) Reset() {
+	*x = StreamForestChunk{}
+	mi := &file_protos_forest_forest_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamForestChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamForestChunk) ProtoMessage() {}
+
+func (x *StreamForestChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamForestChunk.ProtoReflect.Descriptor instead.
+func (*StreamForestChunk) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StreamForestChunk) GetForest() *Forest {
+	if x != nil {
+		return x.Forest
+	}
+	return nil
+}
+
+func (x *StreamForestChunk) GetNodes() []*TreeNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+// 숲의 변경 사항을 실시간으로 받는 RPC
+type WatchForestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchForestRequest) Reset() {
+	*x = WatchForestRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchForestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchForestRequest) ProtoMessage() {}
+
+func (x *WatchForestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchForestRequest.ProtoReflect.Descriptor instead.
+func (*WatchForestRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WatchForestRequest) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+type ForestEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          ForestEventType        `protobuf:"varint,1,opt,name=type,proto3,enum=ForestEventType" json:"type,omitempty"`
+	ForestId      string                 `protobuf:"bytes,2,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	TreeId        string                 `protobuf:"bytes,3,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`       // 트리 이벤트일 때
+	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // TREE_ADDED, TREE_MOVED일 때 새 부모
+	Tree          *Tree                  `protobuf:"bytes,5,opt,name=tree,proto3" json:"tree,omitempty"`                         // TREE_ADDED, TREE_UPDATED, TREE_MOVED, CHILDREN_REORDERED(부모), SUMMARY_COMPLETED일 때
+	Forest        *Forest                `protobuf:"bytes,6,opt,name=forest,proto3" json:"forest,omitempty"`                     // FOREST_UPDATED일 때 (root 제외)
+	UserId        string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 변경한 사용자
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForestEvent) Reset() {
+	*x = ForestEvent{}
+	mi := &file_protos_forest_forest_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForestEvent) ProtoMessage() {}
+
+func (x *ForestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForestEvent.ProtoReflect.Descriptor instead.
+func (*ForestEvent) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ForestEvent) GetType() ForestEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ForestEventType_FOREST_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ForestEvent) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+func (x *ForestEvent) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+func (x *ForestEvent) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ForestEvent) GetTree() *Tree {
+	if x != nil {
+		return x.Tree
+	}
+	return nil
+}
+
+func (x *ForestEvent) GetForest() *Forest {
+	if x != nil {
+		return x.Forest
+	}
+	return nil
+}
+
+func (x *ForestEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ForestEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type GetSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSummaryRequest) Reset() {
+	*x = GetSummaryRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSummaryRequest) ProtoMessage() {}
+
+func (x *GetSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSummaryRequest) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+type GetSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       string                 `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSummaryResponse) Reset() {
+	*x = GetSummaryResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSummaryResponse) ProtoMessage() {}
+
+func (x *GetSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetSummaryResponse) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *GetSummaryResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetForestsByUserRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeChildren bool                   `protobuf:"varint,1,opt,name=include_children,json=includeChildren,proto3" json:"include_children,omitempty"`
+	PageSize        int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 0이면 기본값(50), 최대 500
+	PageToken       string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 이전 응답의 next_page_token
+	SortBy          ForestSortField        `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=ForestSortField" json:"sort_by,omitempty"`
+	Descending      bool                   `protobuf:"varint,5,opt,name=descending,proto3" json:"descending,omitempty"`
+	NamePrefix      string                 `protobuf:"bytes,6,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	MinTrees        int32                  `protobuf:"varint,7,opt,name=min_trees,json=minTrees,proto3" json:"min_trees,omitempty"`                // total_trees가 이 값 이상인 숲만 반환
+	IncludeShared   bool                   `protobuf:"varint,8,opt,name=include_shared,json=includeShared,proto3" json:"include_shared,omitempty"` // 다른 사용자가 공유한 숲도 포함
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetForestsByUserRequest) Reset() {
+	*x = GetForestsByUserRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForestsByUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForestsByUserRequest) ProtoMessage() {}
+
+func (x *GetForestsByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForestsByUserRequest.ProtoReflect.Descriptor instead.
+func (*GetForestsByUserRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetForestsByUserRequest) GetIncludeChildren() bool {
+	if x != nil {
+		return x.IncludeChildren
+	}
+	return false
+}
+
+func (x *GetForestsByUserRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetForestsByUserRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetForestsByUserRequest) GetSortBy() ForestSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return ForestSortField_FOREST_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *GetForestsByUserRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *GetForestsByUserRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *GetForestsByUserRequest) GetMinTrees() int32 {
+	if x != nil {
+		return x.MinTrees
+	}
+	return 0
+}
+
+func (x *GetForestsByUserRequest) GetIncludeShared() bool {
+	if x != nil {
+		return x.IncludeShared
+	}
+	return false
+}
+
+type Tree struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url      string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Children []*Tree                `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
+	Summary  string                 `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	// ListChildren에서만 채워짐
+	ChildCount   int32    `protobuf:"varint,6,opt,name=child_count,json=childCount,proto3" json:"child_count,omitempty"`
+	HasChildren  bool     `protobuf:"varint,7,opt,name=has_children,json=hasChildren,proto3" json:"has_children,omitempty"`
+	Tags         []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`                                     // 이름 순
+	CanonicalUrl string   `protobuf:"bytes,9,opt,name=canonical_url,json=canonicalUrl,proto3" json:"canonical_url,omitempty"` // 서버가 url을 정규화한 값 (중복 확인에 사용)
+	// 방문 기록 집계, 방문한 적이 없으면 시각은 비어 있음
+	FirstVisitedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=first_visited_at,json=firstVisitedAt,proto3" json:"first_visited_at,omitempty"`
+	LastVisitedAt  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_visited_at,json=lastVisitedAt,proto3" json:"last_visited_at,omitempty"`
+	VisitCount     int32                  `protobuf:"varint,12,opt,name=visit_count,json=visitCount,proto3" json:"visit_count,omitempty"`
+	TotalDwellMs   int64                  `protobuf:"varint,13,opt,name=total_dwell_ms,json=totalDwellMs,proto3" json:"total_dwell_ms,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // 이름, URL, 태그가 마지막으로 바뀐 시각 (방문 기록은 포함하지 않음)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Tree) Reset() {
+	*x = Tree{}
+	mi := &file_protos_forest_forest_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tree) ProtoMessage() {}
+
+func (x *Tree) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tree.ProtoReflect.Descriptor instead.
+func (*Tree) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Tree) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tree) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tree) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Tree) GetChildren() []*Tree {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Tree) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *Tree) GetChildCount() int32 {
+	if x != nil {
+		return x.ChildCount
+	}
+	return 0
+}
+
+func (x *Tree) GetHasChildren() bool {
+	if x != nil {
+		return x.HasChildren
+	}
+	return false
+}
+
+func (x *Tree) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Tree) GetCanonicalUrl() string {
+	if x != nil {
+		return x.CanonicalUrl
+	}
+	return ""
+}
+
+func (x *Tree) GetFirstVisitedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstVisitedAt
+	}
+	return nil
+}
+
+func (x *Tree) GetLastVisitedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastVisitedAt
+	}
+	return nil
+}
+
+func (x *Tree) GetVisitCount() int32 {
+	if x != nil {
+		return x.VisitCount
+	}
+	return 0
+}
+
+func (x *Tree) GetTotalDwellMs() int64 {
+	if x != nil {
+		return x.TotalDwellMs
+	}
+	return 0
+}
+
+func (x *Tree) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Tree) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 트리 페이지 방문 기록 (editor 이상 가능)
+type RecordVisitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	VisitedAt     string                 `protobuf:"bytes,2,opt,name=visited_at,json=visitedAt,proto3" json:"visited_at,omitempty"` // RFC3339, 비어 있으면 현재 시각, 현재보다 5분 넘게 미래면 거부
+	DwellMs       int64                  `protobuf:"varint,3,opt,name=dwell_ms,json=dwellMs,proto3" json:"dwell_ms,omitempty"`      // 페이지에 머문 시간, 0 이상
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordVisitRequest) Reset() {
+	*x = RecordVisitRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordVisitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordVisitRequest) ProtoMessage() {}
+
+func (x *RecordVisitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordVisitRequest.ProtoReflect.Descriptor instead.
+func (*RecordVisitRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RecordVisitRequest) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+func (x *RecordVisitRequest) GetVisitedAt() string {
+	if x != nil {
+		return x.VisitedAt
+	}
+	return ""
+}
+
+func (x *RecordVisitRequest) GetDwellMs() int64 {
+	if x != nil {
+		return x.DwellMs
+	}
+	return 0
+}
+
+type CreateTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tree          *Tree                  `protobuf:"bytes,1,opt,name=tree,proto3" json:"tree,omitempty"`
+	Memo          *Memo                  `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	Duplicate     bool                   `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"` // DUPLICATE_POLICY_MERGE로 기존 트리를 반환한 경우 true
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTreeResponse) Reset() {
+	*x = CreateTreeResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTreeResponse) ProtoMessage() {}
+
+func (x *CreateTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTreeResponse.ProtoReflect.Descriptor instead.
+func (*CreateTreeResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateTreeResponse) GetTree() *Tree {
+	if x != nil {
+		return x.Tree
+	}
+	return nil
+}
+
+func (x *CreateTreeResponse) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *CreateTreeResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+type CreateTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Position      *int32                 `protobuf:"varint,5,opt,name=position,proto3,oneof" json:"position,omitempty"` // 형제 중 위치 (0부터 시작), 없거나 범위를 벗어나면 맨 뒤에 추가
+	OnDuplicate   DuplicatePolicy        `protobuf:"varint,6,opt,name=on_duplicate,json=onDuplicate,proto3,enum=DuplicatePolicy" json:"on_duplicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTreeRequest) Reset() {
+	*x = CreateTreeRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTreeRequest) ProtoMessage() {}
+
+func (x *CreateTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTreeRequest.ProtoReflect.Descriptor instead.
+func (*CreateTreeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateTreeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateTreeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTreeRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateTreeRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateTreeRequest) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+func (x *CreateTreeRequest) GetOnDuplicate() DuplicatePolicy {
+	if x != nil {
+		return x.OnDuplicate
+	}
+	return DuplicatePolicy_DUPLICATE_POLICY_ALLOW
+}
+
+type Forest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Root          *Tree                  `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Depth         int32                  `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	TotalTrees    int32                  `protobuf:"varint,6,opt,name=total_trees,json=totalTrees,proto3" json:"total_trees,omitempty"`
+	UserId        string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"` // 이름 순
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // 숲 정보나 트리 구조가 마지막으로 바뀐 시각
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Forest) Reset() {
+	*x = Forest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Forest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Forest) ProtoMessage() {}
+
+func (x *Forest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Forest.ProtoReflect.Descriptor instead.
+func (*Forest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Forest) GetRoot() *Tree {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *Forest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Forest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Forest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Forest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Forest) GetTotalTrees() int32 {
+	if x != nil {
+		return x.TotalTrees
+	}
+	return 0
+}
+
+func (x *Forest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Forest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Forest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Forest) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateForestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Root          *Tree                  `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateForestRequest) Reset() {
+	*x = CreateForestRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateForestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateForestRequest) ProtoMessage() {}
+
+func (x *CreateForestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateForestRequest.ProtoReflect.Descriptor instead.
+func (*CreateForestRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateForestRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateForestRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateForestRequest) GetRoot() *Tree {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+type GetForestsByUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Forests       []*Forest              `protobuf:"bytes,1,rep,name=forests,proto3" json:"forests,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 마지막 페이지면 ""
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForestsByUserResponse) Reset() {
+	*x = GetForestsByUserResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForestsByUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForestsByUserResponse) ProtoMessage() {}
+
+func (x *GetForestsByUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForestsByUserResponse.ProtoReflect.Descriptor instead.
+func (*GetForestsByUserResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetForestsByUserResponse) GetForests() []*Forest {
+	if x != nil {
+		return x.Forests
+	}
+	return nil
+}
+
+func (x *GetForestsByUserResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetForestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ForestId        string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	IncludeChildren bool                   `protobuf:"varint,2,opt,name=include_children,json=includeChildren,proto3" json:"include_children,omitempty"`
+	MaxDepth        int32                  `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"` // include_children일 때 루트 아래로 포함할 깊이, 0이면 제한 없음
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetForestRequest) Reset() {
+	*x = GetForestRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForestRequest) ProtoMessage() {}
+
+func (x *GetForestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForestRequest.ProtoReflect.Descriptor instead.
+func (*GetForestRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetForestRequest) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+func (x *GetForestRequest) GetIncludeChildren() bool {
+	if x != nil {
+		return x.IncludeChildren
+	}
+	return false
+}
+
+func (x *GetForestRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+type GetForestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Forest        *Forest                `protobuf:"bytes,1,opt,name=forest,proto3" json:"forest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForestResponse) Reset() {
+	*x = GetForestResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForestResponse) ProtoMessage() {}
+
+func (x *GetForestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForestResponse.ProtoReflect.Descriptor instead.
+func (*GetForestResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetForestResponse) GetForest() *Forest {
+	if x != nil {
+		return x.Forest
+	}
+	return nil
+}
+
+type UpdateForestRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ForestId    string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// 업데이트할 필드 ("name", "description"). 지정한 필드는 빈 값이어도 그대로 저장됨
+	// 없으면 비어 있지 않은 필드만 업데이트
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateForestRequest) Reset() {
+	*x = UpdateForestRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateForestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateForestRequest) ProtoMessage() {}
+
+func (x *UpdateForestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateForestRequest.ProtoReflect.Descriptor instead.
+func (*UpdateForestRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateForestRequest) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+func (x *UpdateForestRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateForestRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateForestRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// Forest 삭제 RPC
+type DeleteForestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteForestRequest) Reset() {
+	*x = DeleteForestRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteForestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteForestRequest) ProtoMessage() {}
+
+func (x *DeleteForestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteForestRequest.ProtoReflect.Descriptor instead.
+func (*DeleteForestRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteForestRequest) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+type DeleteForestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteForestResponse) Reset() {
+	*x = DeleteForestResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteForestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteForestResponse) ProtoMessage() {}
+
+func (x *DeleteForestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteForestResponse.ProtoReflect.Descriptor instead.
+func (*DeleteForestResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteForestResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UpdateTreeRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TreeId string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url    string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// 업데이트할 필드 ("name", "url"). 지정한 필드는 빈 값이어도 그대로 저장됨
+	// 없으면 비어 있지 않은 필드만 업데이트
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	OnDuplicate   DuplicatePolicy        `protobuf:"varint,5,opt,name=on_duplicate,json=onDuplicate,proto3,enum=DuplicatePolicy" json:"on_duplicate,omitempty"` // url을 바꿀 때 같은 숲에 같은 페이지의 다른 트리가 있으면 적용, MERGE면 바꾸지 않고 기존 트리 반환
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTreeRequest) Reset() {
+	*x = UpdateTreeRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTreeRequest) ProtoMessage() {}
+
+func (x *UpdateTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTreeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTreeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateTreeRequest) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+func (x *UpdateTreeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTreeRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateTreeRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateTreeRequest) GetOnDuplicate() DuplicatePolicy {
+	if x != nil {
+		return x.OnDuplicate
+	}
+	return DuplicatePolicy_DUPLICATE_POLICY_ALLOW
+}
+
+type DeleteTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	Cascade       bool                   `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTreeRequest) Reset() {
+	*x = DeleteTreeRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTreeRequest) ProtoMessage() {}
+
+func (x *DeleteTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTreeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTreeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteTreeRequest) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+func (x *DeleteTreeRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+type DeleteTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTreeResponse) Reset() {
+	*x = DeleteTreeResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTreeResponse) ProtoMessage() {}
+
+func (x *DeleteTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTreeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTreeResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteTreeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 트리(하위 트리 포함)를 다른 부모 아래로 이동하는 RPC
+type MoveTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	NewParentId   string                 `protobuf:"bytes,2,opt,name=new_parent_id,json=newParentId,proto3" json:"new_parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTreeRequest) Reset() {
+	*x = MoveTreeRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTreeRequest) ProtoMessage() {}
+
+func (x *MoveTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTreeRequest.ProtoReflect.Descriptor instead.
+func (*MoveTreeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MoveTreeRequest) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+func (x *MoveTreeRequest) GetNewParentId() string {
+	if x != nil {
+		return x.NewParentId
+	}
+	return ""
+}
+
+// 부모 트리의 자식 순서를 변경하는 RPC
+// ordered_child_ids는 모든 자식을 정확히 한 번씩 포함해야 함
+type ReorderChildrenRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ParentId        string                 `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	OrderedChildIds []string               `protobuf:"bytes,2,rep,name=ordered_child_ids,json=orderedChildIds,proto3" json:"ordered_child_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReorderChildrenRequest) Reset() {
+	*x = ReorderChildrenRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderChildrenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderChildrenRequest) ProtoMessage() {}
+
+func (x *ReorderChildrenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderChildrenRequest.ProtoReflect.Descriptor instead.
+func (*ReorderChildrenRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReorderChildrenRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ReorderChildrenRequest) GetOrderedChildIds() []string {
+	if x != nil {
+		return x.OrderedChildIds
+	}
+	return nil
+}
+
+// 하위 트리를 떼어내 새로운 숲의 루트로 만드는 RPC
+type SplitForestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitForestRequest) Reset() {
+	*x = SplitForestRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitForestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitForestRequest) ProtoMessage() {}
+
+func (x *SplitForestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitForestRequest.ProtoReflect.Descriptor instead.
+func (*SplitForestRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SplitForestRequest) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+func (x *SplitForestRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SplitForestRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// 숲 전체를 다른 숲의 트리 아래로 붙이고 원래 숲은 없애는 RPC
+type GraftForestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	TargetTreeId  string                 `protobuf:"bytes,2,opt,name=target_tree_id,json=targetTreeId,proto3" json:"target_tree_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraftForestRequest) Reset() {
+	*x = GraftForestRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraftForestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraftForestRequest) ProtoMessage() {}
+
+func (x *GraftForestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraftForestRequest.ProtoReflect.Descriptor instead.
+func (*GraftForestRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GraftForestRequest) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+func (x *GraftForestRequest) GetTargetTreeId() string {
+	if x != nil {
+		return x.TargetTreeId
+	}
+	return ""
+}
+
+// 하위 트리 전체를 새 ID로 복사해 다른 트리 아래에 붙이는 RPC
+type CopyTreeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TreeId         string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	TargetParentId string                 `protobuf:"bytes,2,opt,name=target_parent_id,json=targetParentId,proto3" json:"target_parent_id,omitempty"`
+	IncludeMemos   bool                   `protobuf:"varint,3,opt,name=include_memos,json=includeMemos,proto3" json:"include_memos,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CopyTreeRequest) Reset() {
+	*x = CopyTreeRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyTreeRequest) ProtoMessage() {}
+
+func (x *CopyTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyTreeRequest.ProtoReflect.Descriptor instead.
+func (*CopyTreeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CopyTreeRequest) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+func (x *CopyTreeRequest) GetTargetParentId() string {
+	if x != nil {
+		return x.TargetParentId
+	}
+	return ""
+}
+
+func (x *CopyTreeRequest) GetIncludeMemos() bool {
+	if x != nil {
+		return x.IncludeMemos
+	}
+	return false
+}
+
+// 숲 전체를 새 숲으로 복사하는 RPC (name이 비어 있으면 원본 이름 사용)
+type CloneForestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IncludeMemos  bool                   `protobuf:"varint,3,opt,name=include_memos,json=includeMemos,proto3" json:"include_memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneForestRequest) Reset() {
+	*x = CloneForestRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneForestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneForestRequest) ProtoMessage() {}
+
+func (x *CloneForestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneForestRequest.ProtoReflect.Descriptor instead.
+func (*CloneForestRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CloneForestRequest) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+func (x *CloneForestRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneForestRequest) GetIncludeMemos() bool {
+	if x != nil {
+		return x.IncludeMemos
+	}
+	return false
+}
+
+// 휴지통 관련 RPC
+type TrashItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "forest" 또는 "tree"
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ForestId      string                 `protobuf:"bytes,4,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashItem) Reset() {
+	*x = TrashItem{}
+	mi := &file_protos_forest_forest_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashItem) ProtoMessage() {}
+
+func (x *TrashItem) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashItem.ProtoReflect.Descriptor instead.
+func (*TrashItem) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TrashItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TrashItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrashItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrashItem) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+func (x *TrashItem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type ListTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{30}
+}
+
+type ListTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TrashItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListTrashResponse) GetItems() []*TrashItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RestoreForestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreForestRequest) Reset() {
+	*x = RestoreForestRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreForestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreForestRequest) ProtoMessage() {}
+
+func (x *RestoreForestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreForestRequest.ProtoReflect.Descriptor instead.
+func (*RestoreForestRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RestoreForestRequest) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+type RestoreTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTreeRequest) Reset() {
+	*x = RestoreTreeRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTreeRequest) ProtoMessage() {}
+
+func (x *RestoreTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTreeRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTreeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RestoreTreeRequest) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+// 휴지통에 있는 모든 항목과 메모를 영구 삭제
+type PurgeTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTrashRequest) Reset() {
+	*x = PurgeTrashRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashRequest) ProtoMessage() {}
+
+func (x *PurgeTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTrashRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{34}
+}
+
+type PurgeTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purged        int32                  `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTrashResponse) Reset() {
+	*x = PurgeTrashResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashResponse) ProtoMessage() {}
+
+func (x *PurgeTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTrashResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PurgeTrashResponse) GetPurged() int32 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+type ForestMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ForestRole             `protobuf:"varint,2,opt,name=role,proto3,enum=ForestRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForestMember) Reset() {
+	*x = ForestMember{}
+	mi := &file_protos_forest_forest_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForestMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForestMember) ProtoMessage() {}
+
+func (x *ForestMember) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForestMember.ProtoReflect.Descriptor instead.
+func (*ForestMember) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ForestMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ForestMember) GetRole() ForestRole {
+	if x != nil {
+		return x.Role
+	}
+	return ForestRole_FOREST_ROLE_UNSPECIFIED
+}
+
+// 이미 공유된 사용자면 역할을 바꿈 (viewer 또는 editor만 가능)
+type ShareForestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ForestRole             `protobuf:"varint,3,opt,name=role,proto3,enum=ForestRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareForestRequest) Reset() {
+	*x = ShareForestRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareForestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareForestRequest) ProtoMessage() {}
+
+func (x *ShareForestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareForestRequest.ProtoReflect.Descriptor instead.
+func (*ShareForestRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ShareForestRequest) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+func (x *ShareForestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ShareForestRequest) GetRole() ForestRole {
+	if x != nil {
+		return x.Role
+	}
+	return ForestRole_FOREST_ROLE_UNSPECIFIED
+}
+
+// 소유자가 공유를 해제하거나 멤버가 스스로 나감
+type UnshareForestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareForestRequest) Reset() {
+	*x = UnshareForestRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareForestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareForestRequest) ProtoMessage() {}
+
+func (x *UnshareForestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareForestRequest.ProtoReflect.Descriptor instead.
+func (*UnshareForestRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UnshareForestRequest) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+func (x *UnshareForestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnshareForestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareForestResponse) Reset() {
+	*x = UnshareForestResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareForestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareForestResponse) ProtoMessage() {}
+
+func (x *UnshareForestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareForestResponse.ProtoReflect.Descriptor instead.
+func (*UnshareForestResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UnshareForestResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListForestMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListForestMembersRequest) Reset() {
+	*x = ListForestMembersRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListForestMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListForestMembersRequest) ProtoMessage() {}
+
+func (x *ListForestMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListForestMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListForestMembersRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListForestMembersRequest) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+type ListForestMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*ForestMember        `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"` // 소유자가 첫 번째
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListForestMembersResponse) Reset() {
+	*x = ListForestMembersResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListForestMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListForestMembersResponse) ProtoMessage() {}
+
+func (x *ListForestMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListForestMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListForestMembersResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListForestMembersResponse) GetMembers() []*ForestMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Scope         SearchScope            `protobuf:"varint,2,opt,name=scope,proto3,enum=SearchScope" json:"scope,omitempty"`
+	ForestId      string                 `protobuf:"bytes,3,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"` // 지정하면 해당 숲에서만 검색 (viewer 이상 가능)
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                      // 0이면 20, 최대 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetScope() SearchScope {
+	if x != nil {
+		return x.Scope
+	}
+	return SearchScope_SEARCH_SCOPE_ALL
+}
+
+func (x *SearchRequest) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	TreeId        string                 `protobuf:"bytes,2,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	TreeName      string                 `protobuf:"bytes,3,opt,name=tree_name,json=treeName,proto3" json:"tree_name,omitempty"`
+	Source        MatchSource            `protobuf:"varint,4,opt,name=source,proto3,enum=MatchSource" json:"source,omitempty"`
+	Snippet       string                 `protobuf:"bytes,5,opt,name=snippet,proto3" json:"snippet,omitempty"` // HTML 이스케이프된 텍스트, 일치한 단어는 <em></em>으로 감쌈
+	Score         float64                `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_protos_forest_forest_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SearchHit) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+func (x *SearchHit) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+func (x *SearchHit) GetTreeName() string {
+	if x != nil {
+		return x.TreeName
+	}
+	return ""
+}
+
+func (x *SearchHit) GetSource() MatchSource {
+	if x != nil {
+		return x.Source
+	}
+	return MatchSource_MATCH_SOURCE_UNSPECIFIED
+}
+
+func (x *SearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*SearchHit           `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"` // 점수 내림차순
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SearchResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// 호출자가 소유하거나 공유받은 모든 숲에서 같은 페이지의 트리 조회
+type FindTreesByUrlRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // 정규화한 URL로 비교
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindTreesByUrlRequest) Reset() {
+	*x = FindTreesByUrlRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindTreesByUrlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindTreesByUrlRequest) ProtoMessage() {}
+
+func (x *FindTreesByUrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindTreesByUrlRequest.ProtoReflect.Descriptor instead.
+func (*FindTreesByUrlRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *FindTreesByUrlRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type TreeRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreeRef) Reset() {
+	*x = TreeRef{}
+	mi := &file_protos_forest_forest_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreeRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreeRef) ProtoMessage() {}
+
+func (x *TreeRef) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreeRef.ProtoReflect.Descriptor instead.
+func (*TreeRef) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TreeRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TreeRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UrlMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tree          *Tree                  `protobuf:"bytes,1,opt,name=tree,proto3" json:"tree,omitempty"` // children은 비어 있음
+	ForestId      string                 `protobuf:"bytes,2,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	ForestName    string                 `protobuf:"bytes,3,opt,name=forest_name,json=forestName,proto3" json:"forest_name,omitempty"`
+	Path          []*TreeRef             `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"` // 루트부터 트리 자신까지
+	HasSummary    bool                   `protobuf:"varint,5,opt,name=has_summary,json=hasSummary,proto3" json:"has_summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UrlMatch) Reset() {
+	*x = UrlMatch{}
+	mi := &file_protos_forest_forest_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UrlMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UrlMatch) ProtoMessage() {}
+
+func (x *UrlMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UrlMatch.ProtoReflect.Descriptor instead.
+func (*UrlMatch) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UrlMatch) GetTree() *Tree {
+	if x != nil {
+		return x.Tree
+	}
+	return nil
+}
+
+func (x *UrlMatch) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+func (x *UrlMatch) GetForestName() string {
+	if x != nil {
+		return x.ForestName
+	}
+	return ""
+}
+
+func (x *UrlMatch) GetPath() []*TreeRef {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *UrlMatch) GetHasSummary() bool {
+	if x != nil {
+		return x.HasSummary
+	}
+	return false
+}
+
+type FindTreesByUrlResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanonicalUrl  string                 `protobuf:"bytes,1,opt,name=canonical_url,json=canonicalUrl,proto3" json:"canonical_url,omitempty"`
+	Matches       []*UrlMatch            `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"` // 숲 이름, 숲 ID, 깊이, 트리 ID 순
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindTreesByUrlResponse) Reset() {
+	*x = FindTreesByUrlResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindTreesByUrlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindTreesByUrlResponse) ProtoMessage() {}
+
+func (x *FindTreesByUrlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindTreesByUrlResponse.ProtoReflect.Descriptor instead.
+func (*FindTreesByUrlResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *FindTreesByUrlResponse) GetCanonicalUrl() string {
+	if x != nil {
+		return x.CanonicalUrl
+	}
+	return ""
+}
+
+func (x *FindTreesByUrlResponse) GetMatches() []*UrlMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+// 태그 관련 RPC
+// 태그는 숲 소유자별로 관리되며 공유된 숲에서는 소유자의 태그로 저장됨
+// forest_id와 tree_id 중 하나만 지정 (editor 이상 가능)
+type TagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	TreeId        string                 `protobuf:"bytes,2,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"` // 앞뒤 공백은 제거되며 최대 64자
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagsRequest) Reset() {
+	*x = TagsRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsRequest) ProtoMessage() {}
+
+func (x *TagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsRequest.ProtoReflect.Descriptor instead.
+func (*TagsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TagsRequest) GetForestId() string {
+	if x != nil {
+		return x.ForestId
+	}
+	return ""
+}
+
+func (x *TagsRequest) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+func (x *TagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"` // 변경 후 대상의 전체 태그 (이름 순)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagsResponse) Reset() {
+	*x = TagsResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsResponse) ProtoMessage() {}
+
+func (x *TagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsResponse.ProtoReflect.Descriptor instead.
+func (*TagsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{51}
+}
+
+type TagCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TreeCount     int32                  `protobuf:"varint,2,opt,name=tree_count,json=treeCount,proto3" json:"tree_count,omitempty"`
+	ForestCount   int32                  `protobuf:"varint,3,opt,name=forest_count,json=forestCount,proto3" json:"forest_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	mi := &file_protos_forest_forest_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TagCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagCount) GetTreeCount() int32 {
+	if x != nil {
+		return x.TreeCount
+	}
+	return 0
+}
+
+func (x *TagCount) GetForestCount() int32 {
+	if x != nil {
+		return x.ForestCount
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagCount            `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"` // 사용 중인 태그만 이름 순으로 포함
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListTagsResponse) GetTags() []*TagCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// 사용자의 모든 숲에서 태그가 붙은 트리 조회
+type FindTreesByTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindTreesByTagRequest) Reset() {
+	*x = FindTreesByTagRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSummaryRequest) String() string {
+func (x *FindTreesByTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSummaryRequest) ProtoMessage() {}
+func (*FindTreesByTagRequest) ProtoMessage() {}
 
-func (x *GetSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[0]
+func (x *FindTreesByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -53,41 +3676,41 @@ func (x *GetSummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use FindTreesByTagRequest.ProtoReflect.Descriptor instead.
+func (*FindTreesByTagRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetSummaryRequest) GetTreeId() string {
+func (x *FindTreesByTagRequest) GetTag() string {
 	if x != nil {
-		return x.TreeId
+		return x.Tag
 	}
 	return ""
 }
 
-type GetSummaryResponse struct {
+type TaggedTree struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Summary       string                 `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Tree          *Tree                  `protobuf:"bytes,1,opt,name=tree,proto3" json:"tree,omitempty"` // children은 비어 있음
+	ForestId      string                 `protobuf:"bytes,2,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSummaryResponse) Reset() {
-	*x = GetSummaryResponse{}
-	mi := &file_protos_forest_forest_proto_msgTypes[1]
+func (x *TaggedTree) Reset() {
+	*x = TaggedTree{}
+	mi := &file_protos_forest_forest_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSummaryResponse) String() string {
+func (x *TaggedTree) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSummaryResponse) ProtoMessage() {}
+func (*TaggedTree) ProtoMessage() {}
 
-func (x *GetSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[1]
+func (x *TaggedTree) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -98,47 +3721,47 @@ func (x *GetSummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSummaryResponse.ProtoReflect.Descriptor instead.
-func (*GetSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use TaggedTree.ProtoReflect.Descriptor instead.
+func (*TaggedTree) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetSummaryResponse) GetSummary() string {
+func (x *TaggedTree) GetTree() *Tree {
 	if x != nil {
-		return x.Summary
+		return x.Tree
 	}
-	return ""
+	return nil
 }
 
-func (x *GetSummaryResponse) GetStatus() string {
+func (x *TaggedTree) GetForestId() string {
 	if x != nil {
-		return x.Status
+		return x.ForestId
 	}
 	return ""
 }
 
-type GetForestsByUserRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	IncludeChildren bool                   `protobuf:"varint,1,opt,name=include_children,json=includeChildren,proto3" json:"include_children,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type FindTreesByTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trees         []*TaggedTree          `protobuf:"bytes,1,rep,name=trees,proto3" json:"trees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetForestsByUserRequest) Reset() {
-	*x = GetForestsByUserRequest{}
-	mi := &file_protos_forest_forest_proto_msgTypes[2]
+func (x *FindTreesByTagResponse) Reset() {
+	*x = FindTreesByTagResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetForestsByUserRequest) String() string {
+func (x *FindTreesByTagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetForestsByUserRequest) ProtoMessage() {}
+func (*FindTreesByTagResponse) ProtoMessage() {}
 
-func (x *GetForestsByUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[2]
+func (x *FindTreesByTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -149,44 +3772,45 @@ func (x *GetForestsByUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetForestsByUserRequest.ProtoReflect.Descriptor instead.
-func (*GetForestsByUserRequest) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use FindTreesByTagResponse.ProtoReflect.Descriptor instead.
+func (*FindTreesByTagResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetForestsByUserRequest) GetIncludeChildren() bool {
+func (x *FindTreesByTagResponse) GetTrees() []*TaggedTree {
 	if x != nil {
-		return x.IncludeChildren
+		return x.Trees
 	}
-	return false
+	return nil
 }
 
-type Tree struct {
+// 공개 공유 링크 관련 RPC (소유자만 생성/해제 가능)
+type ShareLink struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	Children      []*Tree                `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
-	Summary       string                 `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	ForestId      string                 `protobuf:"bytes,2,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"` // CreateShareLink 응답에만 포함
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Tree) Reset() {
-	*x = Tree{}
-	mi := &file_protos_forest_forest_proto_msgTypes[3]
+func (x *ShareLink) Reset() {
+	*x = ShareLink{}
+	mi := &file_protos_forest_forest_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tree) String() string {
+func (x *ShareLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tree) ProtoMessage() {}
+func (*ShareLink) ProtoMessage() {}
 
-func (x *Tree) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[3]
+func (x *ShareLink) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -197,69 +3821,69 @@ func (x *Tree) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tree.ProtoReflect.Descriptor instead.
-func (*Tree) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ShareLink.ProtoReflect.Descriptor instead.
+func (*ShareLink) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *Tree) GetId() string {
+func (x *ShareLink) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Tree) GetName() string {
+func (x *ShareLink) GetForestId() string {
 	if x != nil {
-		return x.Name
+		return x.ForestId
 	}
 	return ""
 }
 
-func (x *Tree) GetUrl() string {
+func (x *ShareLink) GetToken() string {
 	if x != nil {
-		return x.Url
+		return x.Token
 	}
 	return ""
 }
 
-func (x *Tree) GetChildren() []*Tree {
+func (x *ShareLink) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Children
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *Tree) GetSummary() string {
+func (x *ShareLink) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Summary
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-type CreateTreeResponse struct {
+type CreateShareLinkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tree          *Tree                  `protobuf:"bytes,1,opt,name=tree,proto3" json:"tree,omitempty"`
-	Memo          *Memo                  `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // RFC3339, 비어 있으면 7일 후 만료
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTreeResponse) Reset() {
-	*x = CreateTreeResponse{}
-	mi := &file_protos_forest_forest_proto_msgTypes[4]
+func (x *CreateShareLinkRequest) Reset() {
+	*x = CreateShareLinkRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTreeResponse) String() string {
+func (x *CreateShareLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTreeResponse) ProtoMessage() {}
+func (*CreateShareLinkRequest) ProtoMessage() {}
 
-func (x *CreateTreeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[4]
+func (x *CreateShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -270,50 +3894,47 @@ func (x *CreateTreeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTreeResponse.ProtoReflect.Descriptor instead.
-func (*CreateTreeResponse) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *CreateTreeResponse) GetTree() *Tree {
+func (x *CreateShareLinkRequest) GetForestId() string {
 	if x != nil {
-		return x.Tree
+		return x.ForestId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateTreeResponse) GetMemo() *Memo {
+func (x *CreateShareLinkRequest) GetExpiresAt() string {
 	if x != nil {
-		return x.Memo
+		return x.ExpiresAt
 	}
-	return nil
+	return ""
 }
 
-type CreateTreeRequest struct {
+type ListShareLinksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTreeRequest) Reset() {
-	*x = CreateTreeRequest{}
-	mi := &file_protos_forest_forest_proto_msgTypes[5]
+func (x *ListShareLinksRequest) Reset() {
+	*x = ListShareLinksRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTreeRequest) String() string {
+func (x *ListShareLinksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTreeRequest) ProtoMessage() {}
+func (*ListShareLinksRequest) ProtoMessage() {}
 
-func (x *CreateTreeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[5]
+func (x *ListShareLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,67 +3945,85 @@ func (x *CreateTreeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTreeRequest.ProtoReflect.Descriptor instead.
-func (*CreateTreeRequest) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListShareLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListShareLinksRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *CreateTreeRequest) GetId() string {
+func (x *ListShareLinksRequest) GetForestId() string {
 	if x != nil {
-		return x.Id
+		return x.ForestId
 	}
 	return ""
 }
 
-func (x *CreateTreeRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type ListShareLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*ShareLink           `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"` // 만료되지 않은 링크만 포함
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTreeRequest) GetUrl() string {
+func (x *ListShareLinksResponse) Reset() {
+	*x = ListShareLinksResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShareLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShareLinksResponse) ProtoMessage() {}
+
+func (x *ListShareLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[60]
 	if x != nil {
-		return x.Url
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateTreeRequest) GetParentId() string {
+// Deprecated: Use ListShareLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListShareLinksResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListShareLinksResponse) GetLinks() []*ShareLink {
 	if x != nil {
-		return x.ParentId
+		return x.Links
 	}
-	return ""
+	return nil
 }
 
-type Forest struct {
+type RevokeShareLinkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Root          *Tree                  `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Depth         int32                  `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
-	TotalTrees    int32                  `protobuf:"varint,6,opt,name=total_trees,json=totalTrees,proto3" json:"total_trees,omitempty"`
-	UserId        string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	LinkId        string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Forest) Reset() {
-	*x = Forest{}
-	mi := &file_protos_forest_forest_proto_msgTypes[6]
+func (x *RevokeShareLinkRequest) Reset() {
+	*x = RevokeShareLinkRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Forest) String() string {
+func (x *RevokeShareLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Forest) ProtoMessage() {}
+func (*RevokeShareLinkRequest) ProtoMessage() {}
 
-func (x *Forest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[6]
+func (x *RevokeShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,84 +4034,92 @@ func (x *Forest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Forest.ProtoReflect.Descriptor instead.
-func (*Forest) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RevokeShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokeShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *Forest) GetRoot() *Tree {
+func (x *RevokeShareLinkRequest) GetForestId() string {
 	if x != nil {
-		return x.Root
+		return x.ForestId
 	}
-	return nil
+	return ""
 }
 
-func (x *Forest) GetId() string {
+func (x *RevokeShareLinkRequest) GetLinkId() string {
 	if x != nil {
-		return x.Id
+		return x.LinkId
 	}
 	return ""
 }
 
-func (x *Forest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type RevokeShareLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Forest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *RevokeShareLinkResponse) Reset() {
+	*x = RevokeShareLinkResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Forest) GetDepth() int32 {
-	if x != nil {
-		return x.Depth
-	}
-	return 0
+func (x *RevokeShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Forest) GetTotalTrees() int32 {
+func (*RevokeShareLinkResponse) ProtoMessage() {}
+
+func (x *RevokeShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[62]
 	if x != nil {
-		return x.TotalTrees
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Forest) GetUserId() string {
+// Deprecated: Use RevokeShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*RevokeShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RevokeShareLinkResponse) GetSuccess() bool {
 	if x != nil {
-		return x.UserId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type CreateForestRequest struct {
+// 링크 토큰으로 숲 전체 구조와 요약을 조회 (메모와 소유자 ID는 포함하지 않음)
+type GetSharedForestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Root          *Tree                  `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateForestRequest) Reset() {
-	*x = CreateForestRequest{}
-	mi := &file_protos_forest_forest_proto_msgTypes[7]
+func (x *GetSharedForestRequest) Reset() {
+	*x = GetSharedForestRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateForestRequest) String() string {
+func (x *GetSharedForestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateForestRequest) ProtoMessage() {}
+func (*GetSharedForestRequest) ProtoMessage() {}
 
-func (x *CreateForestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[7]
+func (x *GetSharedForestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -483,54 +4130,94 @@ func (x *CreateForestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateForestRequest.ProtoReflect.Descriptor instead.
-func (*CreateForestRequest) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetSharedForestRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedForestRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *CreateForestRequest) GetName() string {
+func (x *GetSharedForestRequest) GetToken() string {
 	if x != nil {
-		return x.Name
+		return x.Token
 	}
 	return ""
 }
 
-func (x *CreateForestRequest) GetDescription() string {
+type GetSharedForestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Forest        *Forest                `protobuf:"bytes,1,opt,name=forest,proto3" json:"forest,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedForestResponse) Reset() {
+	*x = GetSharedForestResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedForestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedForestResponse) ProtoMessage() {}
+
+func (x *GetSharedForestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedForestResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedForestResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetSharedForestResponse) GetForest() *Forest {
 	if x != nil {
-		return x.Description
+		return x.Forest
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateForestRequest) GetRoot() *Tree {
+func (x *GetSharedForestResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Root
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-type GetForestsByUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Forests       []*Forest              `protobuf:"bytes,1,rep,name=forests,proto3" json:"forests,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetTreeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TreeId          string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	IncludeChildren bool                   `protobuf:"varint,2,opt,name=include_children,json=includeChildren,proto3" json:"include_children,omitempty"`
+	MaxDepth        int32                  `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"` // include_children일 때 포함할 하위 트리 깊이, 0이면 제한 없음
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetForestsByUserResponse) Reset() {
-	*x = GetForestsByUserResponse{}
-	mi := &file_protos_forest_forest_proto_msgTypes[8]
+func (x *GetTreeRequest) Reset() {
+	*x = GetTreeRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetForestsByUserResponse) String() string {
+func (x *GetTreeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetForestsByUserResponse) ProtoMessage() {}
+func (*GetTreeRequest) ProtoMessage() {}
 
-func (x *GetForestsByUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[8]
+func (x *GetTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,41 +4228,57 @@ func (x *GetForestsByUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetForestsByUserResponse.ProtoReflect.Descriptor instead.
-func (*GetForestsByUserResponse) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetTreeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetForestsByUserResponse) GetForests() []*Forest {
+func (x *GetTreeRequest) GetTreeId() string {
 	if x != nil {
-		return x.Forests
+		return x.TreeId
 	}
-	return nil
+	return ""
 }
 
-type GetForestRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ForestId        string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
-	IncludeChildren bool                   `protobuf:"varint,2,opt,name=include_children,json=includeChildren,proto3" json:"include_children,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GetTreeRequest) GetIncludeChildren() bool {
+	if x != nil {
+		return x.IncludeChildren
+	}
+	return false
 }
 
-func (x *GetForestRequest) Reset() {
-	*x = GetForestRequest{}
-	mi := &file_protos_forest_forest_proto_msgTypes[9]
+func (x *GetTreeRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+// 직계 자식을 페이지 단위로 조회하는 RPC
+type ListChildrenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentId      string                 `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0이면 기본값(100), 최대 1000
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChildrenRequest) Reset() {
+	*x = ListChildrenRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetForestRequest) String() string {
+func (x *ListChildrenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetForestRequest) ProtoMessage() {}
+func (*ListChildrenRequest) ProtoMessage() {}
 
-func (x *GetForestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[9]
+func (x *ListChildrenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,47 +4289,55 @@ func (x *GetForestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetForestRequest.ProtoReflect.Descriptor instead.
-func (*GetForestRequest) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListChildrenRequest.ProtoReflect.Descriptor instead.
+func (*ListChildrenRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetForestRequest) GetForestId() string {
+func (x *ListChildrenRequest) GetParentId() string {
 	if x != nil {
-		return x.ForestId
+		return x.ParentId
 	}
 	return ""
 }
 
-func (x *GetForestRequest) GetIncludeChildren() bool {
+func (x *ListChildrenRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.IncludeChildren
+		return x.PageSize
 	}
-	return false
+	return 0
 }
 
-type GetForestResponse struct {
+func (x *ListChildrenRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListChildrenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Forest        *Forest                `protobuf:"bytes,1,opt,name=forest,proto3" json:"forest,omitempty"`
+	Children      []*Tree                `protobuf:"bytes,1,rep,name=children,proto3" json:"children,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetForestResponse) Reset() {
-	*x = GetForestResponse{}
-	mi := &file_protos_forest_forest_proto_msgTypes[10]
+func (x *ListChildrenResponse) Reset() {
+	*x = ListChildrenResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetForestResponse) String() string {
+func (x *ListChildrenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetForestResponse) ProtoMessage() {}
+func (*ListChildrenResponse) ProtoMessage() {}
 
-func (x *GetForestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[10]
+func (x *ListChildrenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -637,42 +4348,51 @@ func (x *GetForestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetForestResponse.ProtoReflect.Descriptor instead.
-func (*GetForestResponse) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListChildrenResponse.ProtoReflect.Descriptor instead.
+func (*ListChildrenResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetForestResponse) GetForest() *Forest {
+func (x *ListChildrenResponse) GetChildren() []*Tree {
 	if x != nil {
-		return x.Forest
+		return x.Children
 	}
 	return nil
 }
 
-type UpdateForestRequest struct {
+func (x *ListChildrenResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Memo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateForestRequest) Reset() {
-	*x = UpdateForestRequest{}
-	mi := &file_protos_forest_forest_proto_msgTypes[11]
+func (x *Memo) Reset() {
+	*x = Memo{}
+	mi := &file_protos_forest_forest_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateForestRequest) String() string {
+func (x *Memo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateForestRequest) ProtoMessage() {}
+func (*Memo) ProtoMessage() {}
 
-func (x *UpdateForestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[11]
+func (x *Memo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,55 +4403,69 @@ func (x *UpdateForestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateForestRequest.ProtoReflect.Descriptor instead.
-func (*UpdateForestRequest) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Memo.ProtoReflect.Descriptor instead.
+func (*Memo) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *UpdateForestRequest) GetForestId() string {
+func (x *Memo) GetTreeId() string {
 	if x != nil {
-		return x.ForestId
+		return x.TreeId
 	}
 	return ""
 }
 
-func (x *UpdateForestRequest) GetName() string {
+func (x *Memo) GetContent() string {
 	if x != nil {
-		return x.Name
+		return x.Content
 	}
 	return ""
 }
 
-func (x *UpdateForestRequest) GetDescription() string {
+func (x *Memo) GetVersion() int32 {
 	if x != nil {
-		return x.Description
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-// Forest 삭제 RPC
-type DeleteForestRequest struct {
+func (x *Memo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Memo) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type UpdateMemoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	Memo          *Memo                  `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteForestRequest) Reset() {
-	*x = DeleteForestRequest{}
-	mi := &file_protos_forest_forest_proto_msgTypes[12]
+func (x *UpdateMemoRequest) Reset() {
+	*x = UpdateMemoRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteForestRequest) String() string {
+func (x *UpdateMemoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteForestRequest) ProtoMessage() {}
+func (*UpdateMemoRequest) ProtoMessage() {}
 
-func (x *DeleteForestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[12]
+func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,40 +4476,49 @@ func (x *DeleteForestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteForestRequest.ProtoReflect.Descriptor instead.
-func (*DeleteForestRequest) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use UpdateMemoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *DeleteForestRequest) GetForestId() string {
+func (x *UpdateMemoRequest) GetMemo() *Memo {
 	if x != nil {
-		return x.ForestId
+		return x.Memo
 	}
-	return ""
+	return nil
 }
 
-type DeleteForestResponse struct {
+func (x *UpdateMemoRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type UpdateMemoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	NewMemo       *Memo                  `protobuf:"bytes,2,opt,name=new_memo,json=newMemo,proto3" json:"new_memo,omitempty"`
+	SyncedAt      string                 `protobuf:"bytes,3,opt,name=synced_at,json=syncedAt,proto3" json:"synced_at,omitempty"` // RFC3339, new_memo.updated_at과 같음
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteForestResponse) Reset() {
-	*x = DeleteForestResponse{}
-	mi := &file_protos_forest_forest_proto_msgTypes[13]
+func (x *UpdateMemoResponse) Reset() {
+	*x = UpdateMemoResponse{}
+	mi := &file_protos_forest_forest_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteForestResponse) String() string {
+func (x *UpdateMemoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteForestResponse) ProtoMessage() {}
+func (*UpdateMemoResponse) ProtoMessage() {}
 
-func (x *DeleteForestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[13]
+func (x *UpdateMemoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,101 +4529,103 @@ func (x *DeleteForestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteForestResponse.ProtoReflect.Descriptor instead.
-func (*DeleteForestResponse) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use UpdateMemoResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMemoResponse) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *DeleteForestResponse) GetSuccess() bool {
+func (x *UpdateMemoResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type UpdateTreeRequest struct {
+func (x *UpdateMemoResponse) GetNewMemo() *Memo {
+	if x != nil {
+		return x.NewMemo
+	}
+	return nil
+}
+
+func (x *UpdateMemoResponse) GetSyncedAt() string {
+	if x != nil {
+		return x.SyncedAt
+	}
+	return ""
+}
+
+type GetMemoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTreeRequest) Reset() {
-	*x = UpdateTreeRequest{}
-	mi := &file_protos_forest_forest_proto_msgTypes[14]
+func (x *GetMemoRequest) Reset() {
+	*x = GetMemoRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTreeRequest) String() string {
+func (x *GetMemoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTreeRequest) ProtoMessage() {}
+func (*GetMemoRequest) ProtoMessage() {}
 
-func (x *UpdateTreeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[14]
+func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateTreeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTreeRequest) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *UpdateTreeRequest) GetTreeId() string {
-	if x != nil {
-		return x.TreeId
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateTreeRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use GetMemoRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *UpdateTreeRequest) GetUrl() string {
+func (x *GetMemoRequest) GetTreeId() string {
 	if x != nil {
-		return x.Url
+		return x.TreeId
 	}
 	return ""
 }
 
-type DeleteTreeRequest struct {
+type HistoryRecord struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
-	Cascade       bool                   `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                          // 비어 있으면 URL을 트리 이름으로 사용
+	VisitedAt     string                 `protobuf:"bytes,3,opt,name=visited_at,json=visitedAt,proto3" json:"visited_at,omitempty"` // RFC3339
+	Referrer      string                 `protobuf:"bytes,4,opt,name=referrer,proto3" json:"referrer,omitempty"`                    // 이 페이지로 오기 전 페이지의 URL
+	Transition    HistoryTransition      `protobuf:"varint,5,opt,name=transition,proto3,enum=HistoryTransition" json:"transition,omitempty"`
+	DwellMs       int64                  `protobuf:"varint,6,opt,name=dwell_ms,json=dwellMs,proto3" json:"dwell_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTreeRequest) Reset() {
-	*x = DeleteTreeRequest{}
-	mi := &file_protos_forest_forest_proto_msgTypes[15]
+func (x *HistoryRecord) Reset() {
+	*x = HistoryRecord{}
+	mi := &file_protos_forest_forest_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTreeRequest) String() string {
+func (x *HistoryRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTreeRequest) ProtoMessage() {}
+func (*HistoryRecord) ProtoMessage() {}
 
-func (x *DeleteTreeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[15]
+func (x *HistoryRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,47 +4636,75 @@ func (x *DeleteTreeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTreeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTreeRequest) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use HistoryRecord.ProtoReflect.Descriptor instead.
+func (*HistoryRecord) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *DeleteTreeRequest) GetTreeId() string {
+func (x *HistoryRecord) GetUrl() string {
 	if x != nil {
-		return x.TreeId
+		return x.Url
 	}
 	return ""
 }
 
-func (x *DeleteTreeRequest) GetCascade() bool {
+func (x *HistoryRecord) GetTitle() string {
 	if x != nil {
-		return x.Cascade
+		return x.Title
 	}
-	return false
+	return ""
 }
 
-type DeleteTreeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *HistoryRecord) GetVisitedAt() string {
+	if x != nil {
+		return x.VisitedAt
+	}
+	return ""
 }
 
-func (x *DeleteTreeResponse) Reset() {
-	*x = DeleteTreeResponse{}
-	mi := &file_protos_forest_forest_proto_msgTypes[16]
+func (x *HistoryRecord) GetReferrer() string {
+	if x != nil {
+		return x.Referrer
+	}
+	return ""
+}
+
+func (x *HistoryRecord) GetTransition() HistoryTransition {
+	if x != nil {
+		return x.Transition
+	}
+	return HistoryTransition_HISTORY_TRANSITION_UNSPECIFIED
+}
+
+func (x *HistoryRecord) GetDwellMs() int64 {
+	if x != nil {
+		return x.DwellMs
+	}
+	return 0
+}
+
+type ImportHistoryOptions struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionGapMinutes int32                  `protobuf:"varint,1,opt,name=session_gap_minutes,json=sessionGapMinutes,proto3" json:"session_gap_minutes,omitempty"` // 이 시간보다 오래 방문이 없으면 새 숲으로 나눔, 0이면 30분
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ImportHistoryOptions) Reset() {
+	*x = ImportHistoryOptions{}
+	mi := &file_protos_forest_forest_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTreeResponse) String() string {
+func (x *ImportHistoryOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTreeResponse) ProtoMessage() {}
+func (*ImportHistoryOptions) ProtoMessage() {}
 
-func (x *DeleteTreeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[16]
+func (x *ImportHistoryOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -942,41 +4715,44 @@ func (x *DeleteTreeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTreeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTreeResponse) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ImportHistoryOptions.ProtoReflect.Descriptor instead.
+func (*ImportHistoryOptions) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *DeleteTreeResponse) GetSuccess() bool {
+func (x *ImportHistoryOptions) GetSessionGapMinutes() int32 {
 	if x != nil {
-		return x.Success
+		return x.SessionGapMinutes
 	}
-	return false
+	return 0
 }
 
-type GetTreeRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	TreeId          string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
-	IncludeChildren bool                   `protobuf:"varint,2,opt,name=include_children,json=includeChildren,proto3" json:"include_children,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ImportHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportHistoryRequest_Options
+	//	*ImportHistoryRequest_Record
+	Payload       isImportHistoryRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTreeRequest) Reset() {
-	*x = GetTreeRequest{}
-	mi := &file_protos_forest_forest_proto_msgTypes[17]
+func (x *ImportHistoryRequest) Reset() {
+	*x = ImportHistoryRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTreeRequest) String() string {
+func (x *ImportHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTreeRequest) ProtoMessage() {}
+func (*ImportHistoryRequest) ProtoMessage() {}
 
-func (x *GetTreeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[17]
+func (x *ImportHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -987,49 +4763,76 @@ func (x *GetTreeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTreeRequest.ProtoReflect.Descriptor instead.
-func (*GetTreeRequest) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ImportHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ImportHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetTreeRequest) GetTreeId() string {
+func (x *ImportHistoryRequest) GetPayload() isImportHistoryRequest_Payload {
 	if x != nil {
-		return x.TreeId
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *GetTreeRequest) GetIncludeChildren() bool {
+func (x *ImportHistoryRequest) GetOptions() *ImportHistoryOptions {
 	if x != nil {
-		return x.IncludeChildren
+		if x, ok := x.Payload.(*ImportHistoryRequest_Options); ok {
+			return x.Options
+		}
 	}
-	return false
+	return nil
 }
 
-type Memo struct {
+func (x *ImportHistoryRequest) GetRecord() *HistoryRecord {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportHistoryRequest_Record); ok {
+			return x.Record
+		}
+	}
+	return nil
+}
+
+type isImportHistoryRequest_Payload interface {
+	isImportHistoryRequest_Payload()
+}
+
+type ImportHistoryRequest_Options struct {
+	Options *ImportHistoryOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportHistoryRequest_Record struct {
+	Record *HistoryRecord `protobuf:"bytes,2,opt,name=record,proto3,oneof"`
+}
+
+func (*ImportHistoryRequest_Options) isImportHistoryRequest_Payload() {}
+
+func (*ImportHistoryRequest_Record) isImportHistoryRequest_Payload() {}
+
+type SkippedRecord struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // record 순서 (0부터)
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Memo) Reset() {
-	*x = Memo{}
-	mi := &file_protos_forest_forest_proto_msgTypes[18]
+func (x *SkippedRecord) Reset() {
+	*x = SkippedRecord{}
+	mi := &file_protos_forest_forest_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Memo) String() string {
+func (x *SkippedRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Memo) ProtoMessage() {}
+func (*SkippedRecord) ProtoMessage() {}
 
-func (x *Memo) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[18]
+func (x *SkippedRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,55 +4843,58 @@ func (x *Memo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Memo.ProtoReflect.Descriptor instead.
-func (*Memo) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SkippedRecord.ProtoReflect.Descriptor instead.
+func (*SkippedRecord) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *Memo) GetTreeId() string {
+func (x *SkippedRecord) GetIndex() int32 {
 	if x != nil {
-		return x.TreeId
+		return x.Index
 	}
-	return ""
+	return 0
 }
 
-func (x *Memo) GetContent() string {
+func (x *SkippedRecord) GetUrl() string {
 	if x != nil {
-		return x.Content
+		return x.Url
 	}
 	return ""
 }
 
-func (x *Memo) GetVersion() int32 {
+func (x *SkippedRecord) GetReason() string {
 	if x != nil {
-		return x.Version
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-type UpdateMemoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Memo          *Memo                  `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ImportHistoryReport struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Forests        []*Forest              `protobuf:"bytes,1,rep,name=forests,proto3" json:"forests,omitempty"` // 만든 숲 (루트 트리의 children은 비어 있음)
+	TreesCreated   int32                  `protobuf:"varint,2,opt,name=trees_created,json=treesCreated,proto3" json:"trees_created,omitempty"`
+	VisitsRecorded int32                  `protobuf:"varint,3,opt,name=visits_recorded,json=visitsRecorded,proto3" json:"visits_recorded,omitempty"`
+	RecordsSkipped int32                  `protobuf:"varint,4,opt,name=records_skipped,json=recordsSkipped,proto3" json:"records_skipped,omitempty"` // 한도를 넘어 받지 않은 기록도 포함
+	Skipped        []*SkippedRecord       `protobuf:"bytes,5,rep,name=skipped,proto3" json:"skipped,omitempty"`                                      // index 순, 한도를 넘은 기록은 담지 않음
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateMemoRequest) Reset() {
-	*x = UpdateMemoRequest{}
-	mi := &file_protos_forest_forest_proto_msgTypes[19]
+func (x *ImportHistoryReport) Reset() {
+	*x = ImportHistoryReport{}
+	mi := &file_protos_forest_forest_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMemoRequest) String() string {
+func (x *ImportHistoryReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMemoRequest) ProtoMessage() {}
+func (*ImportHistoryReport) ProtoMessage() {}
 
-func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[19]
+func (x *ImportHistoryReport) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,49 +4905,70 @@ func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMemoRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMemoRequest) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ImportHistoryReport.ProtoReflect.Descriptor instead.
+func (*ImportHistoryReport) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *UpdateMemoRequest) GetMemo() *Memo {
+func (x *ImportHistoryReport) GetForests() []*Forest {
 	if x != nil {
-		return x.Memo
+		return x.Forests
 	}
 	return nil
 }
 
-func (x *UpdateMemoRequest) GetForce() bool {
+func (x *ImportHistoryReport) GetTreesCreated() int32 {
 	if x != nil {
-		return x.Force
+		return x.TreesCreated
 	}
-	return false
+	return 0
 }
 
-type UpdateMemoResponse struct {
+func (x *ImportHistoryReport) GetVisitsRecorded() int32 {
+	if x != nil {
+		return x.VisitsRecorded
+	}
+	return 0
+}
+
+func (x *ImportHistoryReport) GetRecordsSkipped() int32 {
+	if x != nil {
+		return x.RecordsSkipped
+	}
+	return 0
+}
+
+func (x *ImportHistoryReport) GetSkipped() []*SkippedRecord {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type ExportForestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	NewMemo       *Memo                  `protobuf:"bytes,2,opt,name=new_memo,json=newMemo,proto3" json:"new_memo,omitempty"`
-	SyncedAt      string                 `protobuf:"bytes,3,opt,name=synced_at,json=syncedAt,proto3" json:"synced_at,omitempty"`
+	ForestId      string                 `protobuf:"bytes,1,opt,name=forest_id,json=forestId,proto3" json:"forest_id,omitempty"`
+	Format        ExportFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=ExportFormat" json:"format,omitempty"`
+	IncludeMemos  bool                   `protobuf:"varint,3,opt,name=include_memos,json=includeMemos,proto3" json:"include_memos,omitempty"` // 숲 소유자의 메모를 함께 내보냄
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMemoResponse) Reset() {
-	*x = UpdateMemoResponse{}
-	mi := &file_protos_forest_forest_proto_msgTypes[20]
+func (x *ExportForestRequest) Reset() {
+	*x = ExportForestRequest{}
+	mi := &file_protos_forest_forest_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMemoResponse) String() string {
+func (x *ExportForestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMemoResponse) ProtoMessage() {}
+func (*ExportForestRequest) ProtoMessage() {}
 
-func (x *UpdateMemoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[20]
+func (x *ExportForestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1152,54 +4979,56 @@ func (x *UpdateMemoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMemoResponse.ProtoReflect.Descriptor instead.
-func (*UpdateMemoResponse) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ExportForestRequest.ProtoReflect.Descriptor instead.
+func (*ExportForestRequest) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *UpdateMemoResponse) GetSuccess() bool {
+func (x *ExportForestRequest) GetForestId() string {
 	if x != nil {
-		return x.Success
+		return x.ForestId
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateMemoResponse) GetNewMemo() *Memo {
+func (x *ExportForestRequest) GetFormat() ExportFormat {
 	if x != nil {
-		return x.NewMemo
+		return x.Format
 	}
-	return nil
+	return ExportFormat_EXPORT_FORMAT_JSON
 }
 
-func (x *UpdateMemoResponse) GetSyncedAt() string {
+func (x *ExportForestRequest) GetIncludeMemos() bool {
 	if x != nil {
-		return x.SyncedAt
+		return x.IncludeMemos
 	}
-	return ""
+	return false
 }
 
-type GetMemoRequest struct {
+type ExportForestChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // 첫 번째 청크에만 포함
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`                          // 첫 번째 청크에만 포함
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemoRequest) Reset() {
-	*x = GetMemoRequest{}
-	mi := &file_protos_forest_forest_proto_msgTypes[21]
+func (x *ExportForestChunk) Reset() {
+	*x = ExportForestChunk{}
+	mi := &file_protos_forest_forest_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemoRequest) String() string {
+func (x *ExportForestChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemoRequest) ProtoMessage() {}
+func (*ExportForestChunk) ProtoMessage() {}
 
-func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_forest_forest_proto_msgTypes[21]
+func (x *ExportForestChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_forest_forest_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1210,14 +5039,28 @@ func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemoRequest.ProtoReflect.Descriptor instead.
-func (*GetMemoRequest) Descriptor() ([]byte, []int) {
-	return file_protos_forest_forest_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ExportForestChunk.ProtoReflect.Descriptor instead.
+func (*ExportForestChunk) Descriptor() ([]byte, []int) {
+	return file_protos_forest_forest_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetMemoRequest) GetTreeId() string {
+func (x *ExportForestChunk) GetData() []byte {
 	if x != nil {
-		return x.TreeId
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportForestChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportForestChunk) GetFilename() string {
+	if x != nil {
+		return x.Filename
 	}
 	return ""
 }
@@ -1226,28 +5069,86 @@ var File_protos_forest_forest_proto protoreflect.FileDescriptor
 
 const file_protos_forest_forest_proto_rawDesc = "" +
 	"\n" +
-	"\x1aprotos/forest/forest.proto\",\n" +
+	"\x1aprotos/forest/forest.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"Q\n" +
+	"\x13StreamForestRequest\x12\x1b\n" +
+	"\tforest_id\x18\x01 \x01(\tR\bforestId\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x02 \x01(\x05R\tchunkSize\"X\n" +
+	"\bTreeNode\x12\x19\n" +
+	"\x04tree\x18\x01 \x01(\v2\x05.TreeR\x04tree\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x14\n" +
+	"\x05depth\x18\x03 \x01(\x05R\x05depth\"U\n" +
+	"\x11StreamForestChunk\x12\x1f\n" +
+	"\x06forest\x18\x01 \x01(\v2\a.ForestR\x06forest\x12\x1f\n" +
+	"\x05nodes\x18\x02 \x03(\v2\t.TreeNodeR\x05nodes\"1\n" +
+	"\x12WatchForestRequest\x12\x1b\n" +
+	"\tforest_id\x18\x01 \x01(\tR\bforestId\"\x98\x02\n" +
+	"\vForestEvent\x12$\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x10.ForestEventTypeR\x04type\x12\x1b\n" +
+	"\tforest_id\x18\x02 \x01(\tR\bforestId\x12\x17\n" +
+	"\atree_id\x18\x03 \x01(\tR\x06treeId\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\tR\bparentId\x12\x19\n" +
+	"\x04tree\x18\x05 \x01(\v2\x05.TreeR\x04tree\x12\x1f\n" +
+	"\x06forest\x18\x06 \x01(\v2\a.ForestR\x06forest\x12\x17\n" +
+	"\auser_id\x18\a \x01(\tR\x06userId\x12;\n" +
+	"\voccurred_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\",\n" +
 	"\x11GetSummaryRequest\x12\x17\n" +
 	"\atree_id\x18\x01 \x01(\tR\x06treeId\"F\n" +
 	"\x12GetSummaryResponse\x12\x18\n" +
 	"\asummary\x18\x01 \x01(\tR\asummary\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"D\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xb0\x02\n" +
 	"\x17GetForestsByUserRequest\x12)\n" +
-	"\x10include_children\x18\x01 \x01(\bR\x0fincludeChildren\"y\n" +
+	"\x10include_children\x18\x01 \x01(\bR\x0fincludeChildren\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12)\n" +
+	"\asort_by\x18\x04 \x01(\x0e2\x10.ForestSortFieldR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x05 \x01(\bR\n" +
+	"descending\x12\x1f\n" +
+	"\vname_prefix\x18\x06 \x01(\tR\n" +
+	"namePrefix\x12\x1b\n" +
+	"\tmin_trees\x18\a \x01(\x05R\bminTrees\x12%\n" +
+	"\x0einclude_shared\x18\b \x01(\bR\rincludeShared\"\xbd\x04\n" +
 	"\x04Tree\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
 	"\x03url\x18\x03 \x01(\tR\x03url\x12!\n" +
 	"\bchildren\x18\x04 \x03(\v2\x05.TreeR\bchildren\x12\x18\n" +
-	"\asummary\x18\x05 \x01(\tR\asummary\"J\n" +
+	"\asummary\x18\x05 \x01(\tR\asummary\x12\x1f\n" +
+	"\vchild_count\x18\x06 \x01(\x05R\n" +
+	"childCount\x12!\n" +
+	"\fhas_children\x18\a \x01(\bR\vhasChildren\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12#\n" +
+	"\rcanonical_url\x18\t \x01(\tR\fcanonicalUrl\x12D\n" +
+	"\x10first_visited_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0efirstVisitedAt\x12B\n" +
+	"\x0flast_visited_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rlastVisitedAt\x12\x1f\n" +
+	"\vvisit_count\x18\f \x01(\x05R\n" +
+	"visitCount\x12$\n" +
+	"\x0etotal_dwell_ms\x18\r \x01(\x03R\ftotalDwellMs\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"g\n" +
+	"\x12RecordVisitRequest\x12\x17\n" +
+	"\atree_id\x18\x01 \x01(\tR\x06treeId\x12\x1d\n" +
+	"\n" +
+	"visited_at\x18\x02 \x01(\tR\tvisitedAt\x12\x19\n" +
+	"\bdwell_ms\x18\x03 \x01(\x03R\adwellMs\"h\n" +
 	"\x12CreateTreeResponse\x12\x19\n" +
 	"\x04tree\x18\x01 \x01(\v2\x05.TreeR\x04tree\x12\x19\n" +
-	"\x04memo\x18\x02 \x01(\v2\x05.MemoR\x04memo\"f\n" +
+	"\x04memo\x18\x02 \x01(\v2\x05.MemoR\x04memo\x12\x1c\n" +
+	"\tduplicate\x18\x03 \x01(\bR\tduplicate\"\xc9\x01\n" +
 	"\x11CreateTreeRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
 	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1b\n" +
-	"\tparent_id\x18\x04 \x01(\tR\bparentId\"\xb9\x01\n" +
+	"\tparent_id\x18\x04 \x01(\tR\bparentId\x12\x1f\n" +
+	"\bposition\x18\x05 \x01(\x05H\x00R\bposition\x88\x01\x01\x123\n" +
+	"\fon_duplicate\x18\x06 \x01(\x0e2\x10.DuplicatePolicyR\vonDuplicateB\v\n" +
+	"\t_position\"\xc3\x02\n" +
 	"\x06Forest\x12\x19\n" +
 	"\x04root\x18\x01 \x01(\v2\x05.TreeR\x04root\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
@@ -1256,42 +5157,204 @@ const file_protos_forest_forest_proto_rawDesc = "" +
 	"\x05depth\x18\x05 \x01(\x05R\x05depth\x12\x1f\n" +
 	"\vtotal_trees\x18\x06 \x01(\x05R\n" +
 	"totalTrees\x12\x17\n" +
-	"\auser_id\x18\a \x01(\tR\x06userId\"f\n" +
+	"\auser_id\x18\a \x01(\tR\x06userId\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"f\n" +
 	"\x13CreateForestRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
-	"\x04root\x18\x04 \x01(\v2\x05.TreeR\x04root\"=\n" +
+	"\x04root\x18\x04 \x01(\v2\x05.TreeR\x04root\"e\n" +
 	"\x18GetForestsByUserResponse\x12!\n" +
-	"\aforests\x18\x01 \x03(\v2\a.ForestR\aforests\"Z\n" +
+	"\aforests\x18\x01 \x03(\v2\a.ForestR\aforests\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"w\n" +
 	"\x10GetForestRequest\x12\x1b\n" +
 	"\tforest_id\x18\x01 \x01(\tR\bforestId\x12)\n" +
-	"\x10include_children\x18\x02 \x01(\bR\x0fincludeChildren\"4\n" +
+	"\x10include_children\x18\x02 \x01(\bR\x0fincludeChildren\x12\x1b\n" +
+	"\tmax_depth\x18\x03 \x01(\x05R\bmaxDepth\"4\n" +
 	"\x11GetForestResponse\x12\x1f\n" +
-	"\x06forest\x18\x01 \x01(\v2\a.ForestR\x06forest\"h\n" +
+	"\x06forest\x18\x01 \x01(\v2\a.ForestR\x06forest\"\xa5\x01\n" +
 	"\x13UpdateForestRequest\x12\x1b\n" +
 	"\tforest_id\x18\x01 \x01(\tR\bforestId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"2\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"2\n" +
 	"\x13DeleteForestRequest\x12\x1b\n" +
 	"\tforest_id\x18\x01 \x01(\tR\bforestId\"0\n" +
 	"\x14DeleteForestResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"R\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc4\x01\n" +
 	"\x11UpdateTreeRequest\x12\x17\n" +
 	"\atree_id\x18\x01 \x01(\tR\x06treeId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
-	"\x03url\x18\x03 \x01(\tR\x03url\"F\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x123\n" +
+	"\fon_duplicate\x18\x05 \x01(\x0e2\x10.DuplicatePolicyR\vonDuplicate\"F\n" +
 	"\x11DeleteTreeRequest\x12\x17\n" +
 	"\atree_id\x18\x01 \x01(\tR\x06treeId\x12\x18\n" +
 	"\acascade\x18\x02 \x01(\bR\acascade\".\n" +
 	"\x12DeleteTreeResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"T\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"N\n" +
+	"\x0fMoveTreeRequest\x12\x17\n" +
+	"\atree_id\x18\x01 \x01(\tR\x06treeId\x12\"\n" +
+	"\rnew_parent_id\x18\x02 \x01(\tR\vnewParentId\"a\n" +
+	"\x16ReorderChildrenRequest\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\tR\bparentId\x12*\n" +
+	"\x11ordered_child_ids\x18\x02 \x03(\tR\x0forderedChildIds\"c\n" +
+	"\x12SplitForestRequest\x12\x17\n" +
+	"\atree_id\x18\x01 \x01(\tR\x06treeId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"W\n" +
+	"\x12GraftForestRequest\x12\x1b\n" +
+	"\tforest_id\x18\x01 \x01(\tR\bforestId\x12$\n" +
+	"\x0etarget_tree_id\x18\x02 \x01(\tR\ftargetTreeId\"y\n" +
+	"\x0fCopyTreeRequest\x12\x17\n" +
+	"\atree_id\x18\x01 \x01(\tR\x06treeId\x12(\n" +
+	"\x10target_parent_id\x18\x02 \x01(\tR\x0etargetParentId\x12#\n" +
+	"\rinclude_memos\x18\x03 \x01(\bR\fincludeMemos\"j\n" +
+	"\x12CloneForestRequest\x12\x1b\n" +
+	"\tforest_id\x18\x01 \x01(\tR\bforestId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rinclude_memos\x18\x03 \x01(\bR\fincludeMemos\"\x9b\x01\n" +
+	"\tTrashItem\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\tforest_id\x18\x04 \x01(\tR\bforestId\x129\n" +
+	"\n" +
+	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\x12\n" +
+	"\x10ListTrashRequest\"5\n" +
+	"\x11ListTrashResponse\x12 \n" +
+	"\x05items\x18\x01 \x03(\v2\n" +
+	".TrashItemR\x05items\"3\n" +
+	"\x14RestoreForestRequest\x12\x1b\n" +
+	"\tforest_id\x18\x01 \x01(\tR\bforestId\"-\n" +
+	"\x12RestoreTreeRequest\x12\x17\n" +
+	"\atree_id\x18\x01 \x01(\tR\x06treeId\"\x13\n" +
+	"\x11PurgeTrashRequest\",\n" +
+	"\x12PurgeTrashResponse\x12\x16\n" +
+	"\x06purged\x18\x01 \x01(\x05R\x06purged\"H\n" +
+	"\fForestMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\x04role\x18\x02 \x01(\x0e2\v.ForestRoleR\x04role\"k\n" +
+	"\x12ShareForestRequest\x12\x1b\n" +
+	"\tforest_id\x18\x01 \x01(\tR\bforestId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\x04role\x18\x03 \x01(\x0e2\v.ForestRoleR\x04role\"L\n" +
+	"\x14UnshareForestRequest\x12\x1b\n" +
+	"\tforest_id\x18\x01 \x01(\tR\bforestId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"1\n" +
+	"\x15UnshareForestResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"7\n" +
+	"\x18ListForestMembersRequest\x12\x1b\n" +
+	"\tforest_id\x18\x01 \x01(\tR\bforestId\"D\n" +
+	"\x19ListForestMembersResponse\x12'\n" +
+	"\amembers\x18\x01 \x03(\v2\r.ForestMemberR\amembers\"|\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\"\n" +
+	"\x05scope\x18\x02 \x01(\x0e2\f.SearchScopeR\x05scope\x12\x1b\n" +
+	"\tforest_id\x18\x03 \x01(\tR\bforestId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xb4\x01\n" +
+	"\tSearchHit\x12\x1b\n" +
+	"\tforest_id\x18\x01 \x01(\tR\bforestId\x12\x17\n" +
+	"\atree_id\x18\x02 \x01(\tR\x06treeId\x12\x1b\n" +
+	"\ttree_name\x18\x03 \x01(\tR\btreeName\x12$\n" +
+	"\x06source\x18\x04 \x01(\x0e2\f.MatchSourceR\x06source\x12\x18\n" +
+	"\asnippet\x18\x05 \x01(\tR\asnippet\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score\"0\n" +
+	"\x0eSearchResponse\x12\x1e\n" +
+	"\x04hits\x18\x01 \x03(\v2\n" +
+	".SearchHitR\x04hits\")\n" +
+	"\x15FindTreesByUrlRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"-\n" +
+	"\aTreeRef\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xa2\x01\n" +
+	"\bUrlMatch\x12\x19\n" +
+	"\x04tree\x18\x01 \x01(\v2\x05.TreeR\x04tree\x12\x1b\n" +
+	"\tforest_id\x18\x02 \x01(\tR\bforestId\x12\x1f\n" +
+	"\vforest_name\x18\x03 \x01(\tR\n" +
+	"forestName\x12\x1c\n" +
+	"\x04path\x18\x04 \x03(\v2\b.TreeRefR\x04path\x12\x1f\n" +
+	"\vhas_summary\x18\x05 \x01(\bR\n" +
+	"hasSummary\"b\n" +
+	"\x16FindTreesByUrlResponse\x12#\n" +
+	"\rcanonical_url\x18\x01 \x01(\tR\fcanonicalUrl\x12#\n" +
+	"\amatches\x18\x02 \x03(\v2\t.UrlMatchR\amatches\"W\n" +
+	"\vTagsRequest\x12\x1b\n" +
+	"\tforest_id\x18\x01 \x01(\tR\bforestId\x12\x17\n" +
+	"\atree_id\x18\x02 \x01(\tR\x06treeId\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\"\"\n" +
+	"\fTagsResponse\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"\x11\n" +
+	"\x0fListTagsRequest\"`\n" +
+	"\bTagCount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"tree_count\x18\x02 \x01(\x05R\ttreeCount\x12!\n" +
+	"\fforest_count\x18\x03 \x01(\x05R\vforestCount\"1\n" +
+	"\x10ListTagsResponse\x12\x1d\n" +
+	"\x04tags\x18\x01 \x03(\v2\t.TagCountR\x04tags\")\n" +
+	"\x15FindTreesByTagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\"D\n" +
+	"\n" +
+	"TaggedTree\x12\x19\n" +
+	"\x04tree\x18\x01 \x01(\v2\x05.TreeR\x04tree\x12\x1b\n" +
+	"\tforest_id\x18\x02 \x01(\tR\bforestId\";\n" +
+	"\x16FindTreesByTagResponse\x12!\n" +
+	"\x05trees\x18\x01 \x03(\v2\v.TaggedTreeR\x05trees\"\xc4\x01\n" +
+	"\tShareLink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tforest_id\x18\x02 \x01(\tR\bforestId\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"T\n" +
+	"\x16CreateShareLinkRequest\x12\x1b\n" +
+	"\tforest_id\x18\x01 \x01(\tR\bforestId\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tR\texpiresAt\"4\n" +
+	"\x15ListShareLinksRequest\x12\x1b\n" +
+	"\tforest_id\x18\x01 \x01(\tR\bforestId\":\n" +
+	"\x16ListShareLinksResponse\x12 \n" +
+	"\x05links\x18\x01 \x03(\v2\n" +
+	".ShareLinkR\x05links\"N\n" +
+	"\x16RevokeShareLinkRequest\x12\x1b\n" +
+	"\tforest_id\x18\x01 \x01(\tR\bforestId\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\"3\n" +
+	"\x17RevokeShareLinkResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\".\n" +
+	"\x16GetSharedForestRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"u\n" +
+	"\x17GetSharedForestResponse\x12\x1f\n" +
+	"\x06forest\x18\x01 \x01(\v2\a.ForestR\x06forest\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"q\n" +
 	"\x0eGetTreeRequest\x12\x17\n" +
 	"\atree_id\x18\x01 \x01(\tR\x06treeId\x12)\n" +
-	"\x10include_children\x18\x02 \x01(\bR\x0fincludeChildren\"S\n" +
+	"\x10include_children\x18\x02 \x01(\bR\x0fincludeChildren\x12\x1b\n" +
+	"\tmax_depth\x18\x03 \x01(\x05R\bmaxDepth\"n\n" +
+	"\x13ListChildrenRequest\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\tR\bparentId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"a\n" +
+	"\x14ListChildrenResponse\x12!\n" +
+	"\bchildren\x18\x01 \x03(\v2\x05.TreeR\bchildren\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc9\x01\n" +
 	"\x04Memo\x12\x17\n" +
 	"\atree_id\x18\x01 \x01(\tR\x06treeId\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x18\n" +
-	"\aversion\x18\x03 \x01(\x05R\aversion\"D\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"D\n" +
 	"\x11UpdateMemoRequest\x12\x19\n" +
 	"\x04memo\x18\x01 \x01(\v2\x05.MemoR\x04memo\x12\x14\n" +
 	"\x05force\x18\x02 \x01(\bR\x05force\"m\n" +
@@ -1300,25 +5363,141 @@ const file_protos_forest_forest_proto_rawDesc = "" +
 	"\bnew_memo\x18\x02 \x01(\v2\x05.MemoR\anewMemo\x12\x1b\n" +
 	"\tsynced_at\x18\x03 \x01(\tR\bsyncedAt\")\n" +
 	"\x0eGetMemoRequest\x12\x17\n" +
-	"\atree_id\x18\x01 \x01(\tR\x06treeId2\xf4\x04\n" +
+	"\atree_id\x18\x01 \x01(\tR\x06treeId\"\xc1\x01\n" +
+	"\rHistoryRecord\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"visited_at\x18\x03 \x01(\tR\tvisitedAt\x12\x1a\n" +
+	"\breferrer\x18\x04 \x01(\tR\breferrer\x122\n" +
+	"\n" +
+	"transition\x18\x05 \x01(\x0e2\x12.HistoryTransitionR\n" +
+	"transition\x12\x19\n" +
+	"\bdwell_ms\x18\x06 \x01(\x03R\adwellMs\"F\n" +
+	"\x14ImportHistoryOptions\x12.\n" +
+	"\x13session_gap_minutes\x18\x01 \x01(\x05R\x11sessionGapMinutes\"~\n" +
+	"\x14ImportHistoryRequest\x121\n" +
+	"\aoptions\x18\x01 \x01(\v2\x15.ImportHistoryOptionsH\x00R\aoptions\x12(\n" +
+	"\x06record\x18\x02 \x01(\v2\x0e.HistoryRecordH\x00R\x06recordB\t\n" +
+	"\apayload\"O\n" +
+	"\rSkippedRecord\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xd9\x01\n" +
+	"\x13ImportHistoryReport\x12!\n" +
+	"\aforests\x18\x01 \x03(\v2\a.ForestR\aforests\x12#\n" +
+	"\rtrees_created\x18\x02 \x01(\x05R\ftreesCreated\x12'\n" +
+	"\x0fvisits_recorded\x18\x03 \x01(\x05R\x0evisitsRecorded\x12'\n" +
+	"\x0frecords_skipped\x18\x04 \x01(\x05R\x0erecordsSkipped\x12(\n" +
+	"\askipped\x18\x05 \x03(\v2\x0e.SkippedRecordR\askipped\"~\n" +
+	"\x13ExportForestRequest\x12\x1b\n" +
+	"\tforest_id\x18\x01 \x01(\tR\bforestId\x12%\n" +
+	"\x06format\x18\x02 \x01(\x0e2\r.ExportFormatR\x06format\x12#\n" +
+	"\rinclude_memos\x18\x03 \x01(\bR\fincludeMemos\"f\n" +
+	"\x11ExportForestChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename*\x86\x03\n" +
+	"\x0fForestEventType\x12!\n" +
+	"\x1dFOREST_EVENT_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cFOREST_EVENT_TYPE_TREE_ADDED\x10\x01\x12\"\n" +
+	"\x1eFOREST_EVENT_TYPE_TREE_UPDATED\x10\x02\x12 \n" +
+	"\x1cFOREST_EVENT_TYPE_TREE_MOVED\x10\x03\x12\"\n" +
+	"\x1eFOREST_EVENT_TYPE_TREE_DELETED\x10\x04\x12(\n" +
+	"$FOREST_EVENT_TYPE_CHILDREN_REORDERED\x10\x05\x12$\n" +
+	" FOREST_EVENT_TYPE_FOREST_UPDATED\x10\x06\x12$\n" +
+	" FOREST_EVENT_TYPE_FOREST_DELETED\x10\a\x12'\n" +
+	"#FOREST_EVENT_TYPE_SUMMARY_COMPLETED\x10\b\x12%\n" +
+	"!FOREST_EVENT_TYPE_MEMBERS_CHANGED\x10\t*\x94\x01\n" +
+	"\x0fForestSortField\x12!\n" +
+	"\x1dFOREST_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16FOREST_SORT_FIELD_NAME\x10\x01\x12 \n" +
+	"\x1cFOREST_SORT_FIELD_CREATED_AT\x10\x02\x12 \n" +
+	"\x1cFOREST_SORT_FIELD_UPDATED_AT\x10\x03*f\n" +
+	"\x0fDuplicatePolicy\x12\x1a\n" +
+	"\x16DUPLICATE_POLICY_ALLOW\x10\x00\x12\x1b\n" +
+	"\x17DUPLICATE_POLICY_REJECT\x10\x01\x12\x1a\n" +
+	"\x16DUPLICATE_POLICY_MERGE\x10\x02*p\n" +
+	"\n" +
+	"ForestRole\x12\x1b\n" +
+	"\x17FOREST_ROLE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12FOREST_ROLE_VIEWER\x10\x01\x12\x16\n" +
+	"\x12FOREST_ROLE_EDITOR\x10\x02\x12\x15\n" +
+	"\x11FOREST_ROLE_OWNER\x10\x03*T\n" +
+	"\vSearchScope\x12\x14\n" +
+	"\x10SEARCH_SCOPE_ALL\x10\x00\x12\x16\n" +
+	"\x12SEARCH_SCOPE_OWNED\x10\x01\x12\x17\n" +
+	"\x13SEARCH_SCOPE_SHARED\x10\x02*\x89\x01\n" +
+	"\vMatchSource\x12\x1c\n" +
+	"\x18MATCH_SOURCE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11MATCH_SOURCE_NAME\x10\x01\x12\x14\n" +
+	"\x10MATCH_SOURCE_URL\x10\x02\x12\x18\n" +
+	"\x14MATCH_SOURCE_SUMMARY\x10\x03\x12\x15\n" +
+	"\x11MATCH_SOURCE_MEMO\x10\x04*\x9e\x02\n" +
+	"\x11HistoryTransition\x12\"\n" +
+	"\x1eHISTORY_TRANSITION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17HISTORY_TRANSITION_LINK\x10\x01\x12\x1c\n" +
+	"\x18HISTORY_TRANSITION_TYPED\x10\x02\x12$\n" +
+	" HISTORY_TRANSITION_AUTO_BOOKMARK\x10\x03\x12 \n" +
+	"\x1cHISTORY_TRANSITION_GENERATED\x10\x04\x12\"\n" +
+	"\x1eHISTORY_TRANSITION_FORM_SUBMIT\x10\x05\x12\x1d\n" +
+	"\x19HISTORY_TRANSITION_RELOAD\x10\x06\x12\x1f\n" +
+	"\x1bHISTORY_TRANSITION_SUBFRAME\x10\a*{\n" +
+	"\fExportFormat\x12\x16\n" +
+	"\x12EXPORT_FORMAT_JSON\x10\x00\x12\x1a\n" +
+	"\x16EXPORT_FORMAT_MARKDOWN\x10\x01\x12\x16\n" +
+	"\x12EXPORT_FORMAT_OPML\x10\x02\x12\x1f\n" +
+	"\x1bEXPORT_FORMAT_NETSCAPE_HTML\x10\x032\x8e\x11\n" +
 	"\rForestService\x12G\n" +
 	"\x10GetForestsByUser\x12\x18.GetForestsByUserRequest\x1a\x19.GetForestsByUserResponse\x122\n" +
 	"\tGetForest\x12\x11.GetForestRequest\x1a\x12.GetForestResponse\x12!\n" +
-	"\aGetTree\x12\x0f.GetTreeRequest\x1a\x05.Tree\x12-\n" +
+	"\aGetTree\x12\x0f.GetTreeRequest\x1a\x05.Tree\x12;\n" +
+	"\fListChildren\x12\x14.ListChildrenRequest\x1a\x15.ListChildrenResponse\x12-\n" +
 	"\fCreateForest\x12\x14.CreateForestRequest\x1a\a.Forest\x125\n" +
 	"\n" +
 	"CreateTree\x12\x12.CreateTreeRequest\x1a\x13.CreateTreeResponse\x12-\n" +
 	"\fUpdateForest\x12\x14.UpdateForestRequest\x1a\a.Forest\x12'\n" +
 	"\n" +
-	"UpdateTree\x12\x12.UpdateTreeRequest\x1a\x05.Tree\x12;\n" +
+	"UpdateTree\x12\x12.UpdateTreeRequest\x1a\x05.Tree\x12)\n" +
+	"\vRecordVisit\x12\x13.RecordVisitRequest\x1a\x05.Tree\x12;\n" +
 	"\fDeleteForest\x12\x14.DeleteForestRequest\x1a\x15.DeleteForestResponse\x125\n" +
 	"\n" +
-	"DeleteTree\x12\x12.DeleteTreeRequest\x1a\x13.DeleteTreeResponse\x125\n" +
+	"DeleteTree\x12\x12.DeleteTreeRequest\x1a\x13.DeleteTreeResponse\x12#\n" +
+	"\bMoveTree\x12\x10.MoveTreeRequest\x1a\x05.Tree\x121\n" +
+	"\x0fReorderChildren\x12\x17.ReorderChildrenRequest\x1a\x05.Tree\x12+\n" +
+	"\vSplitForest\x12\x13.SplitForestRequest\x1a\a.Forest\x12+\n" +
+	"\vGraftForest\x12\x13.GraftForestRequest\x1a\a.Forest\x12#\n" +
+	"\bCopyTree\x12\x10.CopyTreeRequest\x1a\x05.Tree\x12+\n" +
+	"\vCloneForest\x12\x13.CloneForestRequest\x1a\a.Forest\x122\n" +
+	"\tListTrash\x12\x11.ListTrashRequest\x1a\x12.ListTrashResponse\x12/\n" +
+	"\rRestoreForest\x12\x15.RestoreForestRequest\x1a\a.Forest\x12)\n" +
+	"\vRestoreTree\x12\x13.RestoreTreeRequest\x1a\x05.Tree\x125\n" +
+	"\n" +
+	"PurgeTrash\x12\x12.PurgeTrashRequest\x1a\x13.PurgeTrashResponse\x121\n" +
+	"\vShareForest\x12\x13.ShareForestRequest\x1a\r.ForestMember\x12>\n" +
+	"\rUnshareForest\x12\x15.UnshareForestRequest\x1a\x16.UnshareForestResponse\x12J\n" +
+	"\x11ListForestMembers\x12\x19.ListForestMembersRequest\x1a\x1a.ListForestMembersResponse\x12&\n" +
+	"\aAddTags\x12\f.TagsRequest\x1a\r.TagsResponse\x12)\n" +
+	"\n" +
+	"RemoveTags\x12\f.TagsRequest\x1a\r.TagsResponse\x12/\n" +
+	"\bListTags\x12\x10.ListTagsRequest\x1a\x11.ListTagsResponse\x12A\n" +
+	"\x0eFindTreesByTag\x12\x16.FindTreesByTagRequest\x1a\x17.FindTreesByTagResponse\x12)\n" +
+	"\x06Search\x12\x0e.SearchRequest\x1a\x0f.SearchResponse\x12A\n" +
+	"\x0eFindTreesByUrl\x12\x16.FindTreesByUrlRequest\x1a\x17.FindTreesByUrlResponse\x126\n" +
+	"\x0fCreateShareLink\x12\x17.CreateShareLinkRequest\x1a\n" +
+	".ShareLink\x12A\n" +
+	"\x0eListShareLinks\x12\x16.ListShareLinksRequest\x1a\x17.ListShareLinksResponse\x12D\n" +
+	"\x0fRevokeShareLink\x12\x17.RevokeShareLinkRequest\x1a\x18.RevokeShareLinkResponse\x12D\n" +
+	"\x0fGetSharedForest\x12\x17.GetSharedForestRequest\x1a\x18.GetSharedForestResponse\x125\n" +
 	"\n" +
 	"UpdateMemo\x12\x12.UpdateMemoRequest\x1a\x13.UpdateMemoResponse\x12!\n" +
 	"\aGetMemo\x12\x0f.GetMemoRequest\x1a\x05.Memo\x127\n" +
 	"\n" +
-	"GetSummary\x12\x12.GetSummaryRequest\x1a\x13.GetSummaryResponse0\x01B2Z0github.com/jdk829355/InForest_back/protos/forestb\x06proto3"
+	"GetSummary\x12\x12.GetSummaryRequest\x1a\x13.GetSummaryResponse0\x01\x12:\n" +
+	"\fStreamForest\x12\x14.StreamForestRequest\x1a\x12.StreamForestChunk0\x01\x122\n" +
+	"\vWatchForest\x12\x13.WatchForestRequest\x1a\f.ForestEvent0\x01\x12>\n" +
+	"\rImportHistory\x12\x15.ImportHistoryRequest\x1a\x14.ImportHistoryReport(\x01\x12:\n" +
+	"\fExportForest\x12\x14.ExportForestRequest\x1a\x12.ExportForestChunk0\x01B2Z0github.com/jdk829355/InForest_back/protos/forestb\x06proto3"
 
 var (
 	file_protos_forest_forest_proto_rawDescOnce sync.Once
@@ -1332,70 +5511,242 @@ func file_protos_forest_forest_proto_rawDescGZIP() []byte {
 	return file_protos_forest_forest_proto_rawDescData
 }
 
-var file_protos_forest_forest_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_protos_forest_forest_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_protos_forest_forest_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
 var file_protos_forest_forest_proto_goTypes = []any{
-	(*GetSummaryRequest)(nil),        // 0: GetSummaryRequest
-	(*GetSummaryResponse)(nil),       // 1: GetSummaryResponse
-	(*GetForestsByUserRequest)(nil),  // 2: GetForestsByUserRequest
-	(*Tree)(nil),                     // 3: Tree
-	(*CreateTreeResponse)(nil),       // 4: CreateTreeResponse
-	(*CreateTreeRequest)(nil),        // 5: CreateTreeRequest
-	(*Forest)(nil),                   // 6: Forest
-	(*CreateForestRequest)(nil),      // 7: CreateForestRequest
-	(*GetForestsByUserResponse)(nil), // 8: GetForestsByUserResponse
-	(*GetForestRequest)(nil),         // 9: GetForestRequest
-	(*GetForestResponse)(nil),        // 10: GetForestResponse
-	(*UpdateForestRequest)(nil),      // 11: UpdateForestRequest
-	(*DeleteForestRequest)(nil),      // 12: DeleteForestRequest
-	(*DeleteForestResponse)(nil),     // 13: DeleteForestResponse
-	(*UpdateTreeRequest)(nil),        // 14: UpdateTreeRequest
-	(*DeleteTreeRequest)(nil),        // 15: DeleteTreeRequest
-	(*DeleteTreeResponse)(nil),       // 16: DeleteTreeResponse
-	(*GetTreeRequest)(nil),           // 17: GetTreeRequest
-	(*Memo)(nil),                     // 18: Memo
-	(*UpdateMemoRequest)(nil),        // 19: UpdateMemoRequest
-	(*UpdateMemoResponse)(nil),       // 20: UpdateMemoResponse
-	(*GetMemoRequest)(nil),           // 21: GetMemoRequest
+	(ForestEventType)(0),              // 0: ForestEventType
+	(ForestSortField)(0),              // 1: ForestSortField
+	(DuplicatePolicy)(0),              // 2: DuplicatePolicy
+	(ForestRole)(0),                   // 3: ForestRole
+	(SearchScope)(0),                  // 4: SearchScope
+	(MatchSource)(0),                  // 5: MatchSource
+	(HistoryTransition)(0),            // 6: HistoryTransition
+	(ExportFormat)(0),                 // 7: ExportFormat
+	(*StreamForestRequest)(nil),       // 8: StreamForestRequest
+	(*TreeNode)(nil),                  // 9: TreeNode
+	(*StreamForestChunk)(nil),         // 10: StreamForestChunk
+	(*WatchForestRequest)(nil),        // 11: WatchForestRequest
+	(*ForestEvent)(nil),               // 12: ForestEvent
+	(*GetSummaryRequest)(nil),         // 13: GetSummaryRequest
+	(*GetSummaryResponse)(nil),        // 14: GetSummaryResponse
+	(*GetForestsByUserRequest)(nil),   // 15: GetForestsByUserRequest
+	(*Tree)(nil),                      // 16: Tree
+	(*RecordVisitRequest)(nil),        // 17: RecordVisitRequest
+	(*CreateTreeResponse)(nil),        // 18: CreateTreeResponse
+	(*CreateTreeRequest)(nil),         // 19: CreateTreeRequest
+	(*Forest)(nil),                    // 20: Forest
+	(*CreateForestRequest)(nil),       // 21: CreateForestRequest
+	(*GetForestsByUserResponse)(nil),  // 22: GetForestsByUserResponse
+	(*GetForestRequest)(nil),          // 23: GetForestRequest
+	(*GetForestResponse)(nil),         // 24: GetForestResponse
+	(*UpdateForestRequest)(nil),       // 25: UpdateForestRequest
+	(*DeleteForestRequest)(nil),       // 26: DeleteForestRequest
+	(*DeleteForestResponse)(nil),      // 27: DeleteForestResponse
+	(*UpdateTreeRequest)(nil),         // 28: UpdateTreeRequest
+	(*DeleteTreeRequest)(nil),         // 29: DeleteTreeRequest
+	(*DeleteTreeResponse)(nil),        // 30: DeleteTreeResponse
+	(*MoveTreeRequest)(nil),           // 31: MoveTreeRequest
+	(*ReorderChildrenRequest)(nil),    // 32: ReorderChildrenRequest
+	(*SplitForestRequest)(nil),        // 33: SplitForestRequest
+	(*GraftForestRequest)(nil),        // 34: GraftForestRequest
+	(*CopyTreeRequest)(nil),           // 35: CopyTreeRequest
+	(*CloneForestRequest)(nil),        // 36: CloneForestRequest
+	(*TrashItem)(nil),                 // 37: TrashItem
+	(*ListTrashRequest)(nil),          // 38: ListTrashRequest
+	(*ListTrashResponse)(nil),         // 39: ListTrashResponse
+	(*RestoreForestRequest)(nil),      // 40: RestoreForestRequest
+	(*RestoreTreeRequest)(nil),        // 41: RestoreTreeRequest
+	(*PurgeTrashRequest)(nil),         // 42: PurgeTrashRequest
+	(*PurgeTrashResponse)(nil),        // 43: PurgeTrashResponse
+	(*ForestMember)(nil),              // 44: ForestMember
+	(*ShareForestRequest)(nil),        // 45: ShareForestRequest
+	(*UnshareForestRequest)(nil),      // 46: UnshareForestRequest
+	(*UnshareForestResponse)(nil),     // 47: UnshareForestResponse
+	(*ListForestMembersRequest)(nil),  // 48: ListForestMembersRequest
+	(*ListForestMembersResponse)(nil), // 49: ListForestMembersResponse
+	(*SearchRequest)(nil),             // 50: SearchRequest
+	(*SearchHit)(nil),                 // 51: SearchHit
+	(*SearchResponse)(nil),            // 52: SearchResponse
+	(*FindTreesByUrlRequest)(nil),     // 53: FindTreesByUrlRequest
+	(*TreeRef)(nil),                   // 54: TreeRef
+	(*UrlMatch)(nil),                  // 55: UrlMatch
+	(*FindTreesByUrlResponse)(nil),    // 56: FindTreesByUrlResponse
+	(*TagsRequest)(nil),               // 57: TagsRequest
+	(*TagsResponse)(nil),              // 58: TagsResponse
+	(*ListTagsRequest)(nil),           // 59: ListTagsRequest
+	(*TagCount)(nil),                  // 60: TagCount
+	(*ListTagsResponse)(nil),          // 61: ListTagsResponse
+	(*FindTreesByTagRequest)(nil),     // 62: FindTreesByTagRequest
+	(*TaggedTree)(nil),                // 63: TaggedTree
+	(*FindTreesByTagResponse)(nil),    // 64: FindTreesByTagResponse
+	(*ShareLink)(nil),                 // 65: ShareLink
+	(*CreateShareLinkRequest)(nil),    // 66: CreateShareLinkRequest
+	(*ListShareLinksRequest)(nil),     // 67: ListShareLinksRequest
+	(*ListShareLinksResponse)(nil),    // 68: ListShareLinksResponse
+	(*RevokeShareLinkRequest)(nil),    // 69: RevokeShareLinkRequest
+	(*RevokeShareLinkResponse)(nil),   // 70: RevokeShareLinkResponse
+	(*GetSharedForestRequest)(nil),    // 71: GetSharedForestRequest
+	(*GetSharedForestResponse)(nil),   // 72: GetSharedForestResponse
+	(*GetTreeRequest)(nil),            // 73: GetTreeRequest
+	(*ListChildrenRequest)(nil),       // 74: ListChildrenRequest
+	(*ListChildrenResponse)(nil),      // 75: ListChildrenResponse
+	(*Memo)(nil),                      // 76: Memo
+	(*UpdateMemoRequest)(nil),         // 77: UpdateMemoRequest
+	(*UpdateMemoResponse)(nil),        // 78: UpdateMemoResponse
+	(*GetMemoRequest)(nil),            // 79: GetMemoRequest
+	(*HistoryRecord)(nil),             // 80: HistoryRecord
+	(*ImportHistoryOptions)(nil),      // 81: ImportHistoryOptions
+	(*ImportHistoryRequest)(nil),      // 82: ImportHistoryRequest
+	(*SkippedRecord)(nil),             // 83: SkippedRecord
+	(*ImportHistoryReport)(nil),       // 84: ImportHistoryReport
+	(*ExportForestRequest)(nil),       // 85: ExportForestRequest
+	(*ExportForestChunk)(nil),         // 86: ExportForestChunk
+	(*timestamppb.Timestamp)(nil),     // 87: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),     // 88: google.protobuf.FieldMask
 }
 var file_protos_forest_forest_proto_depIdxs = []int32{
-	3,  // 0: Tree.children:type_name -> Tree
-	3,  // 1: CreateTreeResponse.tree:type_name -> Tree
-	18, // 2: CreateTreeResponse.memo:type_name -> Memo
-	3,  // 3: Forest.root:type_name -> Tree
-	3,  // 4: CreateForestRequest.root:type_name -> Tree
-	6,  // 5: GetForestsByUserResponse.forests:type_name -> Forest
-	6,  // 6: GetForestResponse.forest:type_name -> Forest
-	18, // 7: UpdateMemoRequest.memo:type_name -> Memo
-	18, // 8: UpdateMemoResponse.new_memo:type_name -> Memo
-	2,  // 9: ForestService.GetForestsByUser:input_type -> GetForestsByUserRequest
-	9,  // 10: ForestService.GetForest:input_type -> GetForestRequest
-	17, // 11: ForestService.GetTree:input_type -> GetTreeRequest
-	7,  // 12: ForestService.CreateForest:input_type -> CreateForestRequest
-	5,  // 13: ForestService.CreateTree:input_type -> CreateTreeRequest
-	11, // 14: ForestService.UpdateForest:input_type -> UpdateForestRequest
-	14, // 15: ForestService.UpdateTree:input_type -> UpdateTreeRequest
-	12, // 16: ForestService.DeleteForest:input_type -> DeleteForestRequest
-	15, // 17: ForestService.DeleteTree:input_type -> DeleteTreeRequest
-	19, // 18: ForestService.UpdateMemo:input_type -> UpdateMemoRequest
-	21, // 19: ForestService.GetMemo:input_type -> GetMemoRequest
-	0,  // 20: ForestService.GetSummary:input_type -> GetSummaryRequest
-	8,  // 21: ForestService.GetForestsByUser:output_type -> GetForestsByUserResponse
-	10, // 22: ForestService.GetForest:output_type -> GetForestResponse
-	3,  // 23: ForestService.GetTree:output_type -> Tree
-	6,  // 24: ForestService.CreateForest:output_type -> Forest
-	4,  // 25: ForestService.CreateTree:output_type -> CreateTreeResponse
-	6,  // 26: ForestService.UpdateForest:output_type -> Forest
-	3,  // 27: ForestService.UpdateTree:output_type -> Tree
-	13, // 28: ForestService.DeleteForest:output_type -> DeleteForestResponse
-	16, // 29: ForestService.DeleteTree:output_type -> DeleteTreeResponse
-	20, // 30: ForestService.UpdateMemo:output_type -> UpdateMemoResponse
-	18, // 31: ForestService.GetMemo:output_type -> Memo
-	1,  // 32: ForestService.GetSummary:output_type -> GetSummaryResponse
-	21, // [21:33] is the sub-list for method output_type
-	9,  // [9:21] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	16, // 0: TreeNode.tree:type_name -> Tree
+	20, // 1: StreamForestChunk.forest:type_name -> Forest
+	9,  // 2: StreamForestChunk.nodes:type_name -> TreeNode
+	0,  // 3: ForestEvent.type:type_name -> ForestEventType
+	16, // 4: ForestEvent.tree:type_name -> Tree
+	20, // 5: ForestEvent.forest:type_name -> Forest
+	87, // 6: ForestEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	1,  // 7: GetForestsByUserRequest.sort_by:type_name -> ForestSortField
+	16, // 8: Tree.children:type_name -> Tree
+	87, // 9: Tree.first_visited_at:type_name -> google.protobuf.Timestamp
+	87, // 10: Tree.last_visited_at:type_name -> google.protobuf.Timestamp
+	87, // 11: Tree.created_at:type_name -> google.protobuf.Timestamp
+	87, // 12: Tree.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 13: CreateTreeResponse.tree:type_name -> Tree
+	76, // 14: CreateTreeResponse.memo:type_name -> Memo
+	2,  // 15: CreateTreeRequest.on_duplicate:type_name -> DuplicatePolicy
+	16, // 16: Forest.root:type_name -> Tree
+	87, // 17: Forest.created_at:type_name -> google.protobuf.Timestamp
+	87, // 18: Forest.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 19: CreateForestRequest.root:type_name -> Tree
+	20, // 20: GetForestsByUserResponse.forests:type_name -> Forest
+	20, // 21: GetForestResponse.forest:type_name -> Forest
+	88, // 22: UpdateForestRequest.update_mask:type_name -> google.protobuf.FieldMask
+	88, // 23: UpdateTreeRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 24: UpdateTreeRequest.on_duplicate:type_name -> DuplicatePolicy
+	87, // 25: TrashItem.deleted_at:type_name -> google.protobuf.Timestamp
+	37, // 26: ListTrashResponse.items:type_name -> TrashItem
+	3,  // 27: ForestMember.role:type_name -> ForestRole
+	3,  // 28: ShareForestRequest.role:type_name -> ForestRole
+	44, // 29: ListForestMembersResponse.members:type_name -> ForestMember
+	4,  // 30: SearchRequest.scope:type_name -> SearchScope
+	5,  // 31: SearchHit.source:type_name -> MatchSource
+	51, // 32: SearchResponse.hits:type_name -> SearchHit
+	16, // 33: UrlMatch.tree:type_name -> Tree
+	54, // 34: UrlMatch.path:type_name -> TreeRef
+	55, // 35: FindTreesByUrlResponse.matches:type_name -> UrlMatch
+	60, // 36: ListTagsResponse.tags:type_name -> TagCount
+	16, // 37: TaggedTree.tree:type_name -> Tree
+	63, // 38: FindTreesByTagResponse.trees:type_name -> TaggedTree
+	87, // 39: ShareLink.expires_at:type_name -> google.protobuf.Timestamp
+	87, // 40: ShareLink.created_at:type_name -> google.protobuf.Timestamp
+	65, // 41: ListShareLinksResponse.links:type_name -> ShareLink
+	20, // 42: GetSharedForestResponse.forest:type_name -> Forest
+	87, // 43: GetSharedForestResponse.expires_at:type_name -> google.protobuf.Timestamp
+	16, // 44: ListChildrenResponse.children:type_name -> Tree
+	87, // 45: Memo.created_at:type_name -> google.protobuf.Timestamp
+	87, // 46: Memo.updated_at:type_name -> google.protobuf.Timestamp
+	76, // 47: UpdateMemoRequest.memo:type_name -> Memo
+	76, // 48: UpdateMemoResponse.new_memo:type_name -> Memo
+	6,  // 49: HistoryRecord.transition:type_name -> HistoryTransition
+	81, // 50: ImportHistoryRequest.options:type_name -> ImportHistoryOptions
+	80, // 51: ImportHistoryRequest.record:type_name -> HistoryRecord
+	20, // 52: ImportHistoryReport.forests:type_name -> Forest
+	83, // 53: ImportHistoryReport.skipped:type_name -> SkippedRecord
+	7,  // 54: ExportForestRequest.format:type_name -> ExportFormat
+	15, // 55: ForestService.GetForestsByUser:input_type -> GetForestsByUserRequest
+	23, // 56: ForestService.GetForest:input_type -> GetForestRequest
+	73, // 57: ForestService.GetTree:input_type -> GetTreeRequest
+	74, // 58: ForestService.ListChildren:input_type -> ListChildrenRequest
+	21, // 59: ForestService.CreateForest:input_type -> CreateForestRequest
+	19, // 60: ForestService.CreateTree:input_type -> CreateTreeRequest
+	25, // 61: ForestService.UpdateForest:input_type -> UpdateForestRequest
+	28, // 62: ForestService.UpdateTree:input_type -> UpdateTreeRequest
+	17, // 63: ForestService.RecordVisit:input_type -> RecordVisitRequest
+	26, // 64: ForestService.DeleteForest:input_type -> DeleteForestRequest
+	29, // 65: ForestService.DeleteTree:input_type -> DeleteTreeRequest
+	31, // 66: ForestService.MoveTree:input_type -> MoveTreeRequest
+	32, // 67: ForestService.ReorderChildren:input_type -> ReorderChildrenRequest
+	33, // 68: ForestService.SplitForest:input_type -> SplitForestRequest
+	34, // 69: ForestService.GraftForest:input_type -> GraftForestRequest
+	35, // 70: ForestService.CopyTree:input_type -> CopyTreeRequest
+	36, // 71: ForestService.CloneForest:input_type -> CloneForestRequest
+	38, // 72: ForestService.ListTrash:input_type -> ListTrashRequest
+	40, // 73: ForestService.RestoreForest:input_type -> RestoreForestRequest
+	41, // 74: ForestService.RestoreTree:input_type -> RestoreTreeRequest
+	42, // 75: ForestService.PurgeTrash:input_type -> PurgeTrashRequest
+	45, // 76: ForestService.ShareForest:input_type -> ShareForestRequest
+	46, // 77: ForestService.UnshareForest:input_type -> UnshareForestRequest
+	48, // 78: ForestService.ListForestMembers:input_type -> ListForestMembersRequest
+	57, // 79: ForestService.AddTags:input_type -> TagsRequest
+	57, // 80: ForestService.RemoveTags:input_type -> TagsRequest
+	59, // 81: ForestService.ListTags:input_type -> ListTagsRequest
+	62, // 82: ForestService.FindTreesByTag:input_type -> FindTreesByTagRequest
+	50, // 83: ForestService.Search:input_type -> SearchRequest
+	53, // 84: ForestService.FindTreesByUrl:input_type -> FindTreesByUrlRequest
+	66, // 85: ForestService.CreateShareLink:input_type -> CreateShareLinkRequest
+	67, // 86: ForestService.ListShareLinks:input_type -> ListShareLinksRequest
+	69, // 87: ForestService.RevokeShareLink:input_type -> RevokeShareLinkRequest
+	71, // 88: ForestService.GetSharedForest:input_type -> GetSharedForestRequest
+	77, // 89: ForestService.UpdateMemo:input_type -> UpdateMemoRequest
+	79, // 90: ForestService.GetMemo:input_type -> GetMemoRequest
+	13, // 91: ForestService.GetSummary:input_type -> GetSummaryRequest
+	8,  // 92: ForestService.StreamForest:input_type -> StreamForestRequest
+	11, // 93: ForestService.WatchForest:input_type -> WatchForestRequest
+	82, // 94: ForestService.ImportHistory:input_type -> ImportHistoryRequest
+	85, // 95: ForestService.ExportForest:input_type -> ExportForestRequest
+	22, // 96: ForestService.GetForestsByUser:output_type -> GetForestsByUserResponse
+	24, // 97: ForestService.GetForest:output_type -> GetForestResponse
+	16, // 98: ForestService.GetTree:output_type -> Tree
+	75, // 99: ForestService.ListChildren:output_type -> ListChildrenResponse
+	20, // 100: ForestService.CreateForest:output_type -> Forest
+	18, // 101: ForestService.CreateTree:output_type -> CreateTreeResponse
+	20, // 102: ForestService.UpdateForest:output_type -> Forest
+	16, // 103: ForestService.UpdateTree:output_type -> Tree
+	16, // 104: ForestService.RecordVisit:output_type -> Tree
+	27, // 105: ForestService.DeleteForest:output_type -> DeleteForestResponse
+	30, // 106: ForestService.DeleteTree:output_type -> DeleteTreeResponse
+	16, // 107: ForestService.MoveTree:output_type -> Tree
+	16, // 108: ForestService.ReorderChildren:output_type -> Tree
+	20, // 109: ForestService.SplitForest:output_type -> Forest
+	20, // 110: ForestService.GraftForest:output_type -> Forest
+	16, // 111: ForestService.CopyTree:output_type -> Tree
+	20, // 112: ForestService.CloneForest:output_type -> Forest
+	39, // 113: ForestService.ListTrash:output_type -> ListTrashResponse
+	20, // 114: ForestService.RestoreForest:output_type -> Forest
+	16, // 115: ForestService.RestoreTree:output_type -> Tree
+	43, // 116: ForestService.PurgeTrash:output_type -> PurgeTrashResponse
+	44, // 117: ForestService.ShareForest:output_type -> ForestMember
+	47, // 118: ForestService.UnshareForest:output_type -> UnshareForestResponse
+	49, // 119: ForestService.ListForestMembers:output_type -> ListForestMembersResponse
+	58, // 120: ForestService.AddTags:output_type -> TagsResponse
+	58, // 121: ForestService.RemoveTags:output_type -> TagsResponse
+	61, // 122: ForestService.ListTags:output_type -> ListTagsResponse
+	64, // 123: ForestService.FindTreesByTag:output_type -> FindTreesByTagResponse
+	52, // 124: ForestService.Search:output_type -> SearchResponse
+	56, // 125: ForestService.FindTreesByUrl:output_type -> FindTreesByUrlResponse
+	65, // 126: ForestService.CreateShareLink:output_type -> ShareLink
+	68, // 127: ForestService.ListShareLinks:output_type -> ListShareLinksResponse
+	70, // 128: ForestService.RevokeShareLink:output_type -> RevokeShareLinkResponse
+	72, // 129: ForestService.GetSharedForest:output_type -> GetSharedForestResponse
+	78, // 130: ForestService.UpdateMemo:output_type -> UpdateMemoResponse
+	76, // 131: ForestService.GetMemo:output_type -> Memo
+	14, // 132: ForestService.GetSummary:output_type -> GetSummaryResponse
+	10, // 133: ForestService.StreamForest:output_type -> StreamForestChunk
+	12, // 134: ForestService.WatchForest:output_type -> ForestEvent
+	84, // 135: ForestService.ImportHistory:output_type -> ImportHistoryReport
+	86, // 136: ForestService.ExportForest:output_type -> ExportForestChunk
+	96, // [96:137] is the sub-list for method output_type
+	55, // [55:96] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_protos_forest_forest_proto_init() }
@@ -1403,18 +5754,24 @@ func file_protos_forest_forest_proto_init() {
 	if File_protos_forest_forest_proto != nil {
 		return
 	}
+	file_protos_forest_forest_proto_msgTypes[11].OneofWrappers = []any{}
+	file_protos_forest_forest_proto_msgTypes[74].OneofWrappers = []any{
+		(*ImportHistoryRequest_Options)(nil),
+		(*ImportHistoryRequest_Record)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_forest_forest_proto_rawDesc), len(file_protos_forest_forest_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   22,
+			NumEnums:      8,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_protos_forest_forest_proto_goTypes,
 		DependencyIndexes: file_protos_forest_forest_proto_depIdxs,
+		EnumInfos:         file_protos_forest_forest_proto_enumTypes,
 		MessageInfos:      file_protos_forest_forest_proto_msgTypes,
 	}.Build()
 	File_protos_forest_forest_proto = out.File
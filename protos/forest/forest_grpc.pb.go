@@ -19,18 +19,47 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ForestService_GetForestsByUser_FullMethodName = "/ForestService/GetForestsByUser"
-	ForestService_GetForest_FullMethodName        = "/ForestService/GetForest"
-	ForestService_GetTree_FullMethodName          = "/ForestService/GetTree"
-	ForestService_CreateForest_FullMethodName     = "/ForestService/CreateForest"
-	ForestService_CreateTree_FullMethodName       = "/ForestService/CreateTree"
-	ForestService_UpdateForest_FullMethodName     = "/ForestService/UpdateForest"
-	ForestService_UpdateTree_FullMethodName       = "/ForestService/UpdateTree"
-	ForestService_DeleteForest_FullMethodName     = "/ForestService/DeleteForest"
-	ForestService_DeleteTree_FullMethodName       = "/ForestService/DeleteTree"
-	ForestService_UpdateMemo_FullMethodName       = "/ForestService/UpdateMemo"
-	ForestService_GetMemo_FullMethodName          = "/ForestService/GetMemo"
-	ForestService_GetSummary_FullMethodName       = "/ForestService/GetSummary"
+	ForestService_GetForestsByUser_FullMethodName  = "/ForestService/GetForestsByUser"
+	ForestService_GetForest_FullMethodName         = "/ForestService/GetForest"
+	ForestService_GetTree_FullMethodName           = "/ForestService/GetTree"
+	ForestService_ListChildren_FullMethodName      = "/ForestService/ListChildren"
+	ForestService_CreateForest_FullMethodName      = "/ForestService/CreateForest"
+	ForestService_CreateTree_FullMethodName        = "/ForestService/CreateTree"
+	ForestService_UpdateForest_FullMethodName      = "/ForestService/UpdateForest"
+	ForestService_UpdateTree_FullMethodName        = "/ForestService/UpdateTree"
+	ForestService_RecordVisit_FullMethodName       = "/ForestService/RecordVisit"
+	ForestService_DeleteForest_FullMethodName      = "/ForestService/DeleteForest"
+	ForestService_DeleteTree_FullMethodName        = "/ForestService/DeleteTree"
+	ForestService_MoveTree_FullMethodName          = "/ForestService/MoveTree"
+	ForestService_ReorderChildren_FullMethodName   = "/ForestService/ReorderChildren"
+	ForestService_SplitForest_FullMethodName       = "/ForestService/SplitForest"
+	ForestService_GraftForest_FullMethodName       = "/ForestService/GraftForest"
+	ForestService_CopyTree_FullMethodName          = "/ForestService/CopyTree"
+	ForestService_CloneForest_FullMethodName       = "/ForestService/CloneForest"
+	ForestService_ListTrash_FullMethodName         = "/ForestService/ListTrash"
+	ForestService_RestoreForest_FullMethodName     = "/ForestService/RestoreForest"
+	ForestService_RestoreTree_FullMethodName       = "/ForestService/RestoreTree"
+	ForestService_PurgeTrash_FullMethodName        = "/ForestService/PurgeTrash"
+	ForestService_ShareForest_FullMethodName       = "/ForestService/ShareForest"
+	ForestService_UnshareForest_FullMethodName     = "/ForestService/UnshareForest"
+	ForestService_ListForestMembers_FullMethodName = "/ForestService/ListForestMembers"
+	ForestService_AddTags_FullMethodName           = "/ForestService/AddTags"
+	ForestService_RemoveTags_FullMethodName        = "/ForestService/RemoveTags"
+	ForestService_ListTags_FullMethodName          = "/ForestService/ListTags"
+	ForestService_FindTreesByTag_FullMethodName    = "/ForestService/FindTreesByTag"
+	ForestService_Search_FullMethodName            = "/ForestService/Search"
+	ForestService_FindTreesByUrl_FullMethodName    = "/ForestService/FindTreesByUrl"
+	ForestService_CreateShareLink_FullMethodName   = "/ForestService/CreateShareLink"
+	ForestService_ListShareLinks_FullMethodName    = "/ForestService/ListShareLinks"
+	ForestService_RevokeShareLink_FullMethodName   = "/ForestService/RevokeShareLink"
+	ForestService_GetSharedForest_FullMethodName   = "/ForestService/GetSharedForest"
+	ForestService_UpdateMemo_FullMethodName        = "/ForestService/UpdateMemo"
+	ForestService_GetMemo_FullMethodName           = "/ForestService/GetMemo"
+	ForestService_GetSummary_FullMethodName        = "/ForestService/GetSummary"
+	ForestService_StreamForest_FullMethodName      = "/ForestService/StreamForest"
+	ForestService_WatchForest_FullMethodName       = "/ForestService/WatchForest"
+	ForestService_ImportHistory_FullMethodName     = "/ForestService/ImportHistory"
+	ForestService_ExportForest_FullMethodName      = "/ForestService/ExportForest"
 )
 
 // ForestServiceClient is the client API for ForestService service.
@@ -42,15 +71,44 @@ type ForestServiceClient interface {
 	GetForestsByUser(ctx context.Context, in *GetForestsByUserRequest, opts ...grpc.CallOption) (*GetForestsByUserResponse, error)
 	GetForest(ctx context.Context, in *GetForestRequest, opts ...grpc.CallOption) (*GetForestResponse, error)
 	GetTree(ctx context.Context, in *GetTreeRequest, opts ...grpc.CallOption) (*Tree, error)
+	ListChildren(ctx context.Context, in *ListChildrenRequest, opts ...grpc.CallOption) (*ListChildrenResponse, error)
 	CreateForest(ctx context.Context, in *CreateForestRequest, opts ...grpc.CallOption) (*Forest, error)
 	CreateTree(ctx context.Context, in *CreateTreeRequest, opts ...grpc.CallOption) (*CreateTreeResponse, error)
 	UpdateForest(ctx context.Context, in *UpdateForestRequest, opts ...grpc.CallOption) (*Forest, error)
 	UpdateTree(ctx context.Context, in *UpdateTreeRequest, opts ...grpc.CallOption) (*Tree, error)
+	RecordVisit(ctx context.Context, in *RecordVisitRequest, opts ...grpc.CallOption) (*Tree, error)
 	DeleteForest(ctx context.Context, in *DeleteForestRequest, opts ...grpc.CallOption) (*DeleteForestResponse, error)
 	DeleteTree(ctx context.Context, in *DeleteTreeRequest, opts ...grpc.CallOption) (*DeleteTreeResponse, error)
+	MoveTree(ctx context.Context, in *MoveTreeRequest, opts ...grpc.CallOption) (*Tree, error)
+	ReorderChildren(ctx context.Context, in *ReorderChildrenRequest, opts ...grpc.CallOption) (*Tree, error)
+	SplitForest(ctx context.Context, in *SplitForestRequest, opts ...grpc.CallOption) (*Forest, error)
+	GraftForest(ctx context.Context, in *GraftForestRequest, opts ...grpc.CallOption) (*Forest, error)
+	CopyTree(ctx context.Context, in *CopyTreeRequest, opts ...grpc.CallOption) (*Tree, error)
+	CloneForest(ctx context.Context, in *CloneForestRequest, opts ...grpc.CallOption) (*Forest, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreForest(ctx context.Context, in *RestoreForestRequest, opts ...grpc.CallOption) (*Forest, error)
+	RestoreTree(ctx context.Context, in *RestoreTreeRequest, opts ...grpc.CallOption) (*Tree, error)
+	PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error)
+	ShareForest(ctx context.Context, in *ShareForestRequest, opts ...grpc.CallOption) (*ForestMember, error)
+	UnshareForest(ctx context.Context, in *UnshareForestRequest, opts ...grpc.CallOption) (*UnshareForestResponse, error)
+	ListForestMembers(ctx context.Context, in *ListForestMembersRequest, opts ...grpc.CallOption) (*ListForestMembersResponse, error)
+	AddTags(ctx context.Context, in *TagsRequest, opts ...grpc.CallOption) (*TagsResponse, error)
+	RemoveTags(ctx context.Context, in *TagsRequest, opts ...grpc.CallOption) (*TagsResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	FindTreesByTag(ctx context.Context, in *FindTreesByTagRequest, opts ...grpc.CallOption) (*FindTreesByTagResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	FindTreesByUrl(ctx context.Context, in *FindTreesByUrlRequest, opts ...grpc.CallOption) (*FindTreesByUrlResponse, error)
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*ShareLink, error)
+	ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error)
+	RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error)
+	GetSharedForest(ctx context.Context, in *GetSharedForestRequest, opts ...grpc.CallOption) (*GetSharedForestResponse, error)
 	UpdateMemo(ctx context.Context, in *UpdateMemoRequest, opts ...grpc.CallOption) (*UpdateMemoResponse, error)
 	GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	GetSummary(ctx context.Context, in *GetSummaryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetSummaryResponse], error)
+	StreamForest(ctx context.Context, in *StreamForestRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamForestChunk], error)
+	WatchForest(ctx context.Context, in *WatchForestRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ForestEvent], error)
+	ImportHistory(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportHistoryRequest, ImportHistoryReport], error)
+	ExportForest(ctx context.Context, in *ExportForestRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportForestChunk], error)
 }
 
 type forestServiceClient struct {
@@ -91,6 +149,16 @@ func (c *forestServiceClient) GetTree(ctx context.Context, in *GetTreeRequest, o
 	return out, nil
 }
 
+func (c *forestServiceClient) ListChildren(ctx context.Context, in *ListChildrenRequest, opts ...grpc.CallOption) (*ListChildrenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChildrenResponse)
+	err := c.cc.Invoke(ctx, ForestService_ListChildren_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *forestServiceClient) CreateForest(ctx context.Context, in *CreateForestRequest, opts ...grpc.CallOption) (*Forest, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Forest)
@@ -131,6 +199,16 @@ func (c *forestServiceClient) UpdateTree(ctx context.Context, in *UpdateTreeRequ
 	return out, nil
 }
 
+func (c *forestServiceClient) RecordVisit(ctx context.Context, in *RecordVisitRequest, opts ...grpc.CallOption) (*Tree, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tree)
+	err := c.cc.Invoke(ctx, ForestService_RecordVisit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *forestServiceClient) DeleteForest(ctx context.Context, in *DeleteForestRequest, opts ...grpc.CallOption) (*DeleteForestResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteForestResponse)
@@ -151,6 +229,236 @@ func (c *forestServiceClient) DeleteTree(ctx context.Context, in *DeleteTreeRequ
 	return out, nil
 }
 
+func (c *forestServiceClient) MoveTree(ctx context.Context, in *MoveTreeRequest, opts ...grpc.CallOption) (*Tree, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tree)
+	err := c.cc.Invoke(ctx, ForestService_MoveTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) ReorderChildren(ctx context.Context, in *ReorderChildrenRequest, opts ...grpc.CallOption) (*Tree, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tree)
+	err := c.cc.Invoke(ctx, ForestService_ReorderChildren_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) SplitForest(ctx context.Context, in *SplitForestRequest, opts ...grpc.CallOption) (*Forest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Forest)
+	err := c.cc.Invoke(ctx, ForestService_SplitForest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) GraftForest(ctx context.Context, in *GraftForestRequest, opts ...grpc.CallOption) (*Forest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Forest)
+	err := c.cc.Invoke(ctx, ForestService_GraftForest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) CopyTree(ctx context.Context, in *CopyTreeRequest, opts ...grpc.CallOption) (*Tree, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tree)
+	err := c.cc.Invoke(ctx, ForestService_CopyTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) CloneForest(ctx context.Context, in *CloneForestRequest, opts ...grpc.CallOption) (*Forest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Forest)
+	err := c.cc.Invoke(ctx, ForestService_CloneForest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, ForestService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) RestoreForest(ctx context.Context, in *RestoreForestRequest, opts ...grpc.CallOption) (*Forest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Forest)
+	err := c.cc.Invoke(ctx, ForestService_RestoreForest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) RestoreTree(ctx context.Context, in *RestoreTreeRequest, opts ...grpc.CallOption) (*Tree, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tree)
+	err := c.cc.Invoke(ctx, ForestService_RestoreTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeTrashResponse)
+	err := c.cc.Invoke(ctx, ForestService_PurgeTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) ShareForest(ctx context.Context, in *ShareForestRequest, opts ...grpc.CallOption) (*ForestMember, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForestMember)
+	err := c.cc.Invoke(ctx, ForestService_ShareForest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) UnshareForest(ctx context.Context, in *UnshareForestRequest, opts ...grpc.CallOption) (*UnshareForestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnshareForestResponse)
+	err := c.cc.Invoke(ctx, ForestService_UnshareForest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) ListForestMembers(ctx context.Context, in *ListForestMembersRequest, opts ...grpc.CallOption) (*ListForestMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListForestMembersResponse)
+	err := c.cc.Invoke(ctx, ForestService_ListForestMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) AddTags(ctx context.Context, in *TagsRequest, opts ...grpc.CallOption) (*TagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagsResponse)
+	err := c.cc.Invoke(ctx, ForestService_AddTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) RemoveTags(ctx context.Context, in *TagsRequest, opts ...grpc.CallOption) (*TagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagsResponse)
+	err := c.cc.Invoke(ctx, ForestService_RemoveTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, ForestService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) FindTreesByTag(ctx context.Context, in *FindTreesByTagRequest, opts ...grpc.CallOption) (*FindTreesByTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindTreesByTagResponse)
+	err := c.cc.Invoke(ctx, ForestService_FindTreesByTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, ForestService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) FindTreesByUrl(ctx context.Context, in *FindTreesByUrlRequest, opts ...grpc.CallOption) (*FindTreesByUrlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindTreesByUrlResponse)
+	err := c.cc.Invoke(ctx, ForestService_FindTreesByUrl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*ShareLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareLink)
+	err := c.cc.Invoke(ctx, ForestService_CreateShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShareLinksResponse)
+	err := c.cc.Invoke(ctx, ForestService_ListShareLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeShareLinkResponse)
+	err := c.cc.Invoke(ctx, ForestService_RevokeShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forestServiceClient) GetSharedForest(ctx context.Context, in *GetSharedForestRequest, opts ...grpc.CallOption) (*GetSharedForestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSharedForestResponse)
+	err := c.cc.Invoke(ctx, ForestService_GetSharedForest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *forestServiceClient) UpdateMemo(ctx context.Context, in *UpdateMemoRequest, opts ...grpc.CallOption) (*UpdateMemoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateMemoResponse)
@@ -168,271 +476,907 @@ func (c *forestServiceClient) GetMemo(ctx context.Context, in *GetMemoRequest, o
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *forestServiceClient) GetSummary(ctx context.Context, in *GetSummaryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetSummaryResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ForestService_ServiceDesc.Streams[0], ForestService_GetSummary_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetSummaryRequest, GetSummaryResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForestService_GetSummaryClient = grpc.ServerStreamingClient[GetSummaryResponse]
+
+func (c *forestServiceClient) StreamForest(ctx context.Context, in *StreamForestRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamForestChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ForestService_ServiceDesc.Streams[1], ForestService_StreamForest_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamForestRequest, StreamForestChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForestService_StreamForestClient = grpc.ServerStreamingClient[StreamForestChunk]
+
+func (c *forestServiceClient) WatchForest(ctx context.Context, in *WatchForestRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ForestEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ForestService_ServiceDesc.Streams[2], ForestService_WatchForest_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchForestRequest, ForestEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForestService_WatchForestClient = grpc.ServerStreamingClient[ForestEvent]
+
+func (c *forestServiceClient) ImportHistory(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportHistoryRequest, ImportHistoryReport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ForestService_ServiceDesc.Streams[3], ForestService_ImportHistory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportHistoryRequest, ImportHistoryReport]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForestService_ImportHistoryClient = grpc.ClientStreamingClient[ImportHistoryRequest, ImportHistoryReport]
+
+func (c *forestServiceClient) ExportForest(ctx context.Context, in *ExportForestRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportForestChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ForestService_ServiceDesc.Streams[4], ForestService_ExportForest_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportForestRequest, ExportForestChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForestService_ExportForestClient = grpc.ServerStreamingClient[ExportForestChunk]
+
+// ForestServiceServer is the server API for ForestService service.
+// All implementations must embed UnimplementedForestServiceServer
+// for forward compatibility.
+//
+// ForestService는 숲과 나무에 대한 CRUD 작업을 처리합니다.
+type ForestServiceServer interface {
+	GetForestsByUser(context.Context, *GetForestsByUserRequest) (*GetForestsByUserResponse, error)
+	GetForest(context.Context, *GetForestRequest) (*GetForestResponse, error)
+	GetTree(context.Context, *GetTreeRequest) (*Tree, error)
+	ListChildren(context.Context, *ListChildrenRequest) (*ListChildrenResponse, error)
+	CreateForest(context.Context, *CreateForestRequest) (*Forest, error)
+	CreateTree(context.Context, *CreateTreeRequest) (*CreateTreeResponse, error)
+	UpdateForest(context.Context, *UpdateForestRequest) (*Forest, error)
+	UpdateTree(context.Context, *UpdateTreeRequest) (*Tree, error)
+	RecordVisit(context.Context, *RecordVisitRequest) (*Tree, error)
+	DeleteForest(context.Context, *DeleteForestRequest) (*DeleteForestResponse, error)
+	DeleteTree(context.Context, *DeleteTreeRequest) (*DeleteTreeResponse, error)
+	MoveTree(context.Context, *MoveTreeRequest) (*Tree, error)
+	ReorderChildren(context.Context, *ReorderChildrenRequest) (*Tree, error)
+	SplitForest(context.Context, *SplitForestRequest) (*Forest, error)
+	GraftForest(context.Context, *GraftForestRequest) (*Forest, error)
+	CopyTree(context.Context, *CopyTreeRequest) (*Tree, error)
+	CloneForest(context.Context, *CloneForestRequest) (*Forest, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreForest(context.Context, *RestoreForestRequest) (*Forest, error)
+	RestoreTree(context.Context, *RestoreTreeRequest) (*Tree, error)
+	PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error)
+	ShareForest(context.Context, *ShareForestRequest) (*ForestMember, error)
+	UnshareForest(context.Context, *UnshareForestRequest) (*UnshareForestResponse, error)
+	ListForestMembers(context.Context, *ListForestMembersRequest) (*ListForestMembersResponse, error)
+	AddTags(context.Context, *TagsRequest) (*TagsResponse, error)
+	RemoveTags(context.Context, *TagsRequest) (*TagsResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	FindTreesByTag(context.Context, *FindTreesByTagRequest) (*FindTreesByTagResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	FindTreesByUrl(context.Context, *FindTreesByUrlRequest) (*FindTreesByUrlResponse, error)
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*ShareLink, error)
+	ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error)
+	RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error)
+	GetSharedForest(context.Context, *GetSharedForestRequest) (*GetSharedForestResponse, error)
+	UpdateMemo(context.Context, *UpdateMemoRequest) (*UpdateMemoResponse, error)
+	GetMemo(context.Context, *GetMemoRequest) (*Memo, error)
+	GetSummary(*GetSummaryRequest, grpc.ServerStreamingServer[GetSummaryResponse]) error
+	StreamForest(*StreamForestRequest, grpc.ServerStreamingServer[StreamForestChunk]) error
+	WatchForest(*WatchForestRequest, grpc.ServerStreamingServer[ForestEvent]) error
+	ImportHistory(grpc.ClientStreamingServer[ImportHistoryRequest, ImportHistoryReport]) error
+	ExportForest(*ExportForestRequest, grpc.ServerStreamingServer[ExportForestChunk]) error
+	mustEmbedUnimplementedForestServiceServer()
+}
+
+// UnimplementedForestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedForestServiceServer struct{}
+
+func (UnimplementedForestServiceServer) GetForestsByUser(context.Context, *GetForestsByUserRequest) (*GetForestsByUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForestsByUser not implemented")
+}
+func (UnimplementedForestServiceServer) GetForest(context.Context, *GetForestRequest) (*GetForestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForest not implemented")
+}
+func (UnimplementedForestServiceServer) GetTree(context.Context, *GetTreeRequest) (*Tree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTree not implemented")
+}
+func (UnimplementedForestServiceServer) ListChildren(context.Context, *ListChildrenRequest) (*ListChildrenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChildren not implemented")
+}
+func (UnimplementedForestServiceServer) CreateForest(context.Context, *CreateForestRequest) (*Forest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateForest not implemented")
+}
+func (UnimplementedForestServiceServer) CreateTree(context.Context, *CreateTreeRequest) (*CreateTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTree not implemented")
+}
+func (UnimplementedForestServiceServer) UpdateForest(context.Context, *UpdateForestRequest) (*Forest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateForest not implemented")
+}
+func (UnimplementedForestServiceServer) UpdateTree(context.Context, *UpdateTreeRequest) (*Tree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTree not implemented")
+}
+func (UnimplementedForestServiceServer) RecordVisit(context.Context, *RecordVisitRequest) (*Tree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordVisit not implemented")
+}
+func (UnimplementedForestServiceServer) DeleteForest(context.Context, *DeleteForestRequest) (*DeleteForestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteForest not implemented")
+}
+func (UnimplementedForestServiceServer) DeleteTree(context.Context, *DeleteTreeRequest) (*DeleteTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTree not implemented")
+}
+func (UnimplementedForestServiceServer) MoveTree(context.Context, *MoveTreeRequest) (*Tree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTree not implemented")
+}
+func (UnimplementedForestServiceServer) ReorderChildren(context.Context, *ReorderChildrenRequest) (*Tree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderChildren not implemented")
+}
+func (UnimplementedForestServiceServer) SplitForest(context.Context, *SplitForestRequest) (*Forest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitForest not implemented")
+}
+func (UnimplementedForestServiceServer) GraftForest(context.Context, *GraftForestRequest) (*Forest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GraftForest not implemented")
+}
+func (UnimplementedForestServiceServer) CopyTree(context.Context, *CopyTreeRequest) (*Tree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyTree not implemented")
+}
+func (UnimplementedForestServiceServer) CloneForest(context.Context, *CloneForestRequest) (*Forest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneForest not implemented")
+}
+func (UnimplementedForestServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedForestServiceServer) RestoreForest(context.Context, *RestoreForestRequest) (*Forest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreForest not implemented")
+}
+func (UnimplementedForestServiceServer) RestoreTree(context.Context, *RestoreTreeRequest) (*Tree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTree not implemented")
+}
+func (UnimplementedForestServiceServer) PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTrash not implemented")
+}
+func (UnimplementedForestServiceServer) ShareForest(context.Context, *ShareForestRequest) (*ForestMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareForest not implemented")
+}
+func (UnimplementedForestServiceServer) UnshareForest(context.Context, *UnshareForestRequest) (*UnshareForestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareForest not implemented")
+}
+func (UnimplementedForestServiceServer) ListForestMembers(context.Context, *ListForestMembersRequest) (*ListForestMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListForestMembers not implemented")
+}
+func (UnimplementedForestServiceServer) AddTags(context.Context, *TagsRequest) (*TagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTags not implemented")
+}
+func (UnimplementedForestServiceServer) RemoveTags(context.Context, *TagsRequest) (*TagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTags not implemented")
+}
+func (UnimplementedForestServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedForestServiceServer) FindTreesByTag(context.Context, *FindTreesByTagRequest) (*FindTreesByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindTreesByTag not implemented")
+}
+func (UnimplementedForestServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedForestServiceServer) FindTreesByUrl(context.Context, *FindTreesByUrlRequest) (*FindTreesByUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindTreesByUrl not implemented")
+}
+func (UnimplementedForestServiceServer) CreateShareLink(context.Context, *CreateShareLinkRequest) (*ShareLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShareLink not implemented")
+}
+func (UnimplementedForestServiceServer) ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShareLinks not implemented")
+}
+func (UnimplementedForestServiceServer) RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShareLink not implemented")
+}
+func (UnimplementedForestServiceServer) GetSharedForest(context.Context, *GetSharedForestRequest) (*GetSharedForestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedForest not implemented")
+}
+func (UnimplementedForestServiceServer) UpdateMemo(context.Context, *UpdateMemoRequest) (*UpdateMemoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemo not implemented")
+}
+func (UnimplementedForestServiceServer) GetMemo(context.Context, *GetMemoRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemo not implemented")
+}
+func (UnimplementedForestServiceServer) GetSummary(*GetSummaryRequest, grpc.ServerStreamingServer[GetSummaryResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetSummary not implemented")
+}
+func (UnimplementedForestServiceServer) StreamForest(*StreamForestRequest, grpc.ServerStreamingServer[StreamForestChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamForest not implemented")
+}
+func (UnimplementedForestServiceServer) WatchForest(*WatchForestRequest, grpc.ServerStreamingServer[ForestEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchForest not implemented")
+}
+func (UnimplementedForestServiceServer) ImportHistory(grpc.ClientStreamingServer[ImportHistoryRequest, ImportHistoryReport]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportHistory not implemented")
+}
+func (UnimplementedForestServiceServer) ExportForest(*ExportForestRequest, grpc.ServerStreamingServer[ExportForestChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportForest not implemented")
+}
+func (UnimplementedForestServiceServer) mustEmbedUnimplementedForestServiceServer() {}
+func (UnimplementedForestServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeForestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ForestServiceServer will
+// result in compilation errors.
+type UnsafeForestServiceServer interface {
+	mustEmbedUnimplementedForestServiceServer()
+}
+
+func RegisterForestServiceServer(s grpc.ServiceRegistrar, srv ForestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedForestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ForestService_ServiceDesc, srv)
+}
+
+func _ForestService_GetForestsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForestsByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).GetForestsByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_GetForestsByUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).GetForestsByUser(ctx, req.(*GetForestsByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_GetForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).GetForest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_GetForest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).GetForest(ctx, req.(*GetForestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_GetTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).GetTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_GetTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).GetTree(ctx, req.(*GetTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_ListChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).ListChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_ListChildren_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).ListChildren(ctx, req.(*ListChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_CreateForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateForestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).CreateForest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_CreateForest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).CreateForest(ctx, req.(*CreateForestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_CreateTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).CreateTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_CreateTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).CreateTree(ctx, req.(*CreateTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_UpdateForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateForestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).UpdateForest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_UpdateForest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).UpdateForest(ctx, req.(*UpdateForestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_UpdateTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).UpdateTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_UpdateTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).UpdateTree(ctx, req.(*UpdateTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_RecordVisit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordVisitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).RecordVisit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_RecordVisit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).RecordVisit(ctx, req.(*RecordVisitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_DeleteForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteForestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).DeleteForest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_DeleteForest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).DeleteForest(ctx, req.(*DeleteForestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_DeleteTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).DeleteTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_DeleteTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).DeleteTree(ctx, req.(*DeleteTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_MoveTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).MoveTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_MoveTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).MoveTree(ctx, req.(*MoveTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_ReorderChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).ReorderChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_ReorderChildren_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).ReorderChildren(ctx, req.(*ReorderChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_SplitForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitForestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).SplitForest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_SplitForest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).SplitForest(ctx, req.(*SplitForestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_GraftForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GraftForestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).GraftForest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_GraftForest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).GraftForest(ctx, req.(*GraftForestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_CopyTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).CopyTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_CopyTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).CopyTree(ctx, req.(*CopyTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_CloneForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneForestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).CloneForest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_CloneForest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).CloneForest(ctx, req.(*CloneForestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_RestoreForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreForestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).RestoreForest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_RestoreForest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).RestoreForest(ctx, req.(*RestoreForestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_RestoreTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).RestoreTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_RestoreTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).RestoreTree(ctx, req.(*RestoreTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_PurgeTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).PurgeTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_PurgeTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).PurgeTrash(ctx, req.(*PurgeTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForestService_ShareForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareForestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).ShareForest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_ShareForest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).ShareForest(ctx, req.(*ShareForestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *forestServiceClient) GetSummary(ctx context.Context, in *GetSummaryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetSummaryResponse], error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &ForestService_ServiceDesc.Streams[0], ForestService_GetSummary_FullMethodName, cOpts...)
-	if err != nil {
+func _ForestService_UnshareForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareForestRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[GetSummaryRequest, GetSummaryResponse]{ClientStream: stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(ForestServiceServer).UnshareForest(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_UnshareForest_FullMethodName,
 	}
-	return x, nil
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ForestService_GetSummaryClient = grpc.ServerStreamingClient[GetSummaryResponse]
-
-// ForestServiceServer is the server API for ForestService service.
-// All implementations must embed UnimplementedForestServiceServer
-// for forward compatibility.
-//
-// ForestService는 숲과 나무에 대한 CRUD 작업을 처리합니다.
-type ForestServiceServer interface {
-	GetForestsByUser(context.Context, *GetForestsByUserRequest) (*GetForestsByUserResponse, error)
-	GetForest(context.Context, *GetForestRequest) (*GetForestResponse, error)
-	GetTree(context.Context, *GetTreeRequest) (*Tree, error)
-	CreateForest(context.Context, *CreateForestRequest) (*Forest, error)
-	CreateTree(context.Context, *CreateTreeRequest) (*CreateTreeResponse, error)
-	UpdateForest(context.Context, *UpdateForestRequest) (*Forest, error)
-	UpdateTree(context.Context, *UpdateTreeRequest) (*Tree, error)
-	DeleteForest(context.Context, *DeleteForestRequest) (*DeleteForestResponse, error)
-	DeleteTree(context.Context, *DeleteTreeRequest) (*DeleteTreeResponse, error)
-	UpdateMemo(context.Context, *UpdateMemoRequest) (*UpdateMemoResponse, error)
-	GetMemo(context.Context, *GetMemoRequest) (*Memo, error)
-	GetSummary(*GetSummaryRequest, grpc.ServerStreamingServer[GetSummaryResponse]) error
-	mustEmbedUnimplementedForestServiceServer()
-}
-
-// UnimplementedForestServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedForestServiceServer struct{}
-
-func (UnimplementedForestServiceServer) GetForestsByUser(context.Context, *GetForestsByUserRequest) (*GetForestsByUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForestsByUser not implemented")
-}
-func (UnimplementedForestServiceServer) GetForest(context.Context, *GetForestRequest) (*GetForestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForest not implemented")
-}
-func (UnimplementedForestServiceServer) GetTree(context.Context, *GetTreeRequest) (*Tree, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTree not implemented")
-}
-func (UnimplementedForestServiceServer) CreateForest(context.Context, *CreateForestRequest) (*Forest, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateForest not implemented")
-}
-func (UnimplementedForestServiceServer) CreateTree(context.Context, *CreateTreeRequest) (*CreateTreeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTree not implemented")
-}
-func (UnimplementedForestServiceServer) UpdateForest(context.Context, *UpdateForestRequest) (*Forest, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateForest not implemented")
-}
-func (UnimplementedForestServiceServer) UpdateTree(context.Context, *UpdateTreeRequest) (*Tree, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTree not implemented")
-}
-func (UnimplementedForestServiceServer) DeleteForest(context.Context, *DeleteForestRequest) (*DeleteForestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteForest not implemented")
-}
-func (UnimplementedForestServiceServer) DeleteTree(context.Context, *DeleteTreeRequest) (*DeleteTreeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTree not implemented")
-}
-func (UnimplementedForestServiceServer) UpdateMemo(context.Context, *UpdateMemoRequest) (*UpdateMemoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemo not implemented")
-}
-func (UnimplementedForestServiceServer) GetMemo(context.Context, *GetMemoRequest) (*Memo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMemo not implemented")
-}
-func (UnimplementedForestServiceServer) GetSummary(*GetSummaryRequest, grpc.ServerStreamingServer[GetSummaryResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method GetSummary not implemented")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).UnshareForest(ctx, req.(*UnshareForestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedForestServiceServer) mustEmbedUnimplementedForestServiceServer() {}
-func (UnimplementedForestServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeForestServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ForestServiceServer will
-// result in compilation errors.
-type UnsafeForestServiceServer interface {
-	mustEmbedUnimplementedForestServiceServer()
+func _ForestService_ListForestMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListForestMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForestServiceServer).ListForestMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_ListForestMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).ListForestMembers(ctx, req.(*ListForestMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterForestServiceServer(s grpc.ServiceRegistrar, srv ForestServiceServer) {
-	// If the following call pancis, it indicates UnimplementedForestServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ForestService_AddTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ForestService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ForestServiceServer).AddTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForestService_AddTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForestServiceServer).AddTags(ctx, req.(*TagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ForestService_GetForestsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForestsByUserRequest)
+func _ForestService_RemoveTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ForestServiceServer).GetForestsByUser(ctx, in)
+		return srv.(ForestServiceServer).RemoveTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ForestService_GetForestsByUser_FullMethodName,
+		FullMethod: ForestService_RemoveTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForestServiceServer).GetForestsByUser(ctx, req.(*GetForestsByUserRequest))
+		return srv.(ForestServiceServer).RemoveTags(ctx, req.(*TagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ForestService_GetForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForestRequest)
+func _ForestService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ForestServiceServer).GetForest(ctx, in)
+		return srv.(ForestServiceServer).ListTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ForestService_GetForest_FullMethodName,
+		FullMethod: ForestService_ListTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForestServiceServer).GetForest(ctx, req.(*GetForestRequest))
+		return srv.(ForestServiceServer).ListTags(ctx, req.(*ListTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ForestService_GetTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTreeRequest)
+func _ForestService_FindTreesByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindTreesByTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ForestServiceServer).GetTree(ctx, in)
+		return srv.(ForestServiceServer).FindTreesByTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ForestService_GetTree_FullMethodName,
+		FullMethod: ForestService_FindTreesByTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForestServiceServer).GetTree(ctx, req.(*GetTreeRequest))
+		return srv.(ForestServiceServer).FindTreesByTag(ctx, req.(*FindTreesByTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ForestService_CreateForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateForestRequest)
+func _ForestService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ForestServiceServer).CreateForest(ctx, in)
+		return srv.(ForestServiceServer).Search(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ForestService_CreateForest_FullMethodName,
+		FullMethod: ForestService_Search_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForestServiceServer).CreateForest(ctx, req.(*CreateForestRequest))
+		return srv.(ForestServiceServer).Search(ctx, req.(*SearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ForestService_CreateTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTreeRequest)
+func _ForestService_FindTreesByUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindTreesByUrlRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ForestServiceServer).CreateTree(ctx, in)
+		return srv.(ForestServiceServer).FindTreesByUrl(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ForestService_CreateTree_FullMethodName,
+		FullMethod: ForestService_FindTreesByUrl_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForestServiceServer).CreateTree(ctx, req.(*CreateTreeRequest))
+		return srv.(ForestServiceServer).FindTreesByUrl(ctx, req.(*FindTreesByUrlRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ForestService_UpdateForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateForestRequest)
+func _ForestService_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ForestServiceServer).UpdateForest(ctx, in)
+		return srv.(ForestServiceServer).CreateShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ForestService_UpdateForest_FullMethodName,
+		FullMethod: ForestService_CreateShareLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForestServiceServer).UpdateForest(ctx, req.(*UpdateForestRequest))
+		return srv.(ForestServiceServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ForestService_UpdateTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTreeRequest)
+func _ForestService_ListShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShareLinksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ForestServiceServer).UpdateTree(ctx, in)
+		return srv.(ForestServiceServer).ListShareLinks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ForestService_UpdateTree_FullMethodName,
+		FullMethod: ForestService_ListShareLinks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForestServiceServer).UpdateTree(ctx, req.(*UpdateTreeRequest))
+		return srv.(ForestServiceServer).ListShareLinks(ctx, req.(*ListShareLinksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ForestService_DeleteForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteForestRequest)
+func _ForestService_RevokeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ForestServiceServer).DeleteForest(ctx, in)
+		return srv.(ForestServiceServer).RevokeShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ForestService_DeleteForest_FullMethodName,
+		FullMethod: ForestService_RevokeShareLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForestServiceServer).DeleteForest(ctx, req.(*DeleteForestRequest))
+		return srv.(ForestServiceServer).RevokeShareLink(ctx, req.(*RevokeShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ForestService_DeleteTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTreeRequest)
+func _ForestService_GetSharedForest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedForestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ForestServiceServer).DeleteTree(ctx, in)
+		return srv.(ForestServiceServer).GetSharedForest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ForestService_DeleteTree_FullMethodName,
+		FullMethod: ForestService_GetSharedForest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForestServiceServer).DeleteTree(ctx, req.(*DeleteTreeRequest))
+		return srv.(ForestServiceServer).GetSharedForest(ctx, req.(*GetSharedForestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -484,6 +1428,46 @@ func _ForestService_GetSummary_Handler(srv interface{}, stream grpc.ServerStream
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ForestService_GetSummaryServer = grpc.ServerStreamingServer[GetSummaryResponse]
 
+func _ForestService_StreamForest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamForestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ForestServiceServer).StreamForest(m, &grpc.GenericServerStream[StreamForestRequest, StreamForestChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForestService_StreamForestServer = grpc.ServerStreamingServer[StreamForestChunk]
+
+func _ForestService_WatchForest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchForestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ForestServiceServer).WatchForest(m, &grpc.GenericServerStream[WatchForestRequest, ForestEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForestService_WatchForestServer = grpc.ServerStreamingServer[ForestEvent]
+
+func _ForestService_ImportHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ForestServiceServer).ImportHistory(&grpc.GenericServerStream[ImportHistoryRequest, ImportHistoryReport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForestService_ImportHistoryServer = grpc.ClientStreamingServer[ImportHistoryRequest, ImportHistoryReport]
+
+func _ForestService_ExportForest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportForestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ForestServiceServer).ExportForest(m, &grpc.GenericServerStream[ExportForestRequest, ExportForestChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForestService_ExportForestServer = grpc.ServerStreamingServer[ExportForestChunk]
+
 // ForestService_ServiceDesc is the grpc.ServiceDesc for ForestService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -503,6 +1487,10 @@ var ForestService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTree",
 			Handler:    _ForestService_GetTree_Handler,
 		},
+		{
+			MethodName: "ListChildren",
+			Handler:    _ForestService_ListChildren_Handler,
+		},
 		{
 			MethodName: "CreateForest",
 			Handler:    _ForestService_CreateForest_Handler,
@@ -519,6 +1507,10 @@ var ForestService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTree",
 			Handler:    _ForestService_UpdateTree_Handler,
 		},
+		{
+			MethodName: "RecordVisit",
+			Handler:    _ForestService_RecordVisit_Handler,
+		},
 		{
 			MethodName: "DeleteForest",
 			Handler:    _ForestService_DeleteForest_Handler,
@@ -527,6 +1519,98 @@ var ForestService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTree",
 			Handler:    _ForestService_DeleteTree_Handler,
 		},
+		{
+			MethodName: "MoveTree",
+			Handler:    _ForestService_MoveTree_Handler,
+		},
+		{
+			MethodName: "ReorderChildren",
+			Handler:    _ForestService_ReorderChildren_Handler,
+		},
+		{
+			MethodName: "SplitForest",
+			Handler:    _ForestService_SplitForest_Handler,
+		},
+		{
+			MethodName: "GraftForest",
+			Handler:    _ForestService_GraftForest_Handler,
+		},
+		{
+			MethodName: "CopyTree",
+			Handler:    _ForestService_CopyTree_Handler,
+		},
+		{
+			MethodName: "CloneForest",
+			Handler:    _ForestService_CloneForest_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _ForestService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreForest",
+			Handler:    _ForestService_RestoreForest_Handler,
+		},
+		{
+			MethodName: "RestoreTree",
+			Handler:    _ForestService_RestoreTree_Handler,
+		},
+		{
+			MethodName: "PurgeTrash",
+			Handler:    _ForestService_PurgeTrash_Handler,
+		},
+		{
+			MethodName: "ShareForest",
+			Handler:    _ForestService_ShareForest_Handler,
+		},
+		{
+			MethodName: "UnshareForest",
+			Handler:    _ForestService_UnshareForest_Handler,
+		},
+		{
+			MethodName: "ListForestMembers",
+			Handler:    _ForestService_ListForestMembers_Handler,
+		},
+		{
+			MethodName: "AddTags",
+			Handler:    _ForestService_AddTags_Handler,
+		},
+		{
+			MethodName: "RemoveTags",
+			Handler:    _ForestService_RemoveTags_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _ForestService_ListTags_Handler,
+		},
+		{
+			MethodName: "FindTreesByTag",
+			Handler:    _ForestService_FindTreesByTag_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _ForestService_Search_Handler,
+		},
+		{
+			MethodName: "FindTreesByUrl",
+			Handler:    _ForestService_FindTreesByUrl_Handler,
+		},
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _ForestService_CreateShareLink_Handler,
+		},
+		{
+			MethodName: "ListShareLinks",
+			Handler:    _ForestService_ListShareLinks_Handler,
+		},
+		{
+			MethodName: "RevokeShareLink",
+			Handler:    _ForestService_RevokeShareLink_Handler,
+		},
+		{
+			MethodName: "GetSharedForest",
+			Handler:    _ForestService_GetSharedForest_Handler,
+		},
 		{
 			MethodName: "UpdateMemo",
 			Handler:    _ForestService_UpdateMemo_Handler,
@@ -542,6 +1626,26 @@ var ForestService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ForestService_GetSummary_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamForest",
+			Handler:       _ForestService_StreamForest_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchForest",
+			Handler:       _ForestService_WatchForest_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportHistory",
+			Handler:       _ForestService_ImportHistory_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportForest",
+			Handler:       _ForestService_ExportForest_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "protos/forest/forest.proto",
 }
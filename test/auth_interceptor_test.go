@@ -0,0 +1,37 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jdk829355/InForest_back/internal/grpc/interceptors/authinterceptor"
+	"github.com/jdk829355/InForest_back/internal/service/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptorPublicMethods(t *testing.T) {
+	t.Parallel()
+
+	svc, err := auth.NewAuthService("test-secret")
+	if err != nil {
+		t.Fatalf("unexpected error creating service: %v", err)
+	}
+	interceptor, err := authinterceptor.NewAuthInterceptor(svc, "/ForestService/GetSharedForest")
+	if err != nil {
+		t.Fatalf("unexpected error creating interceptor: %v", err)
+	}
+	unary := interceptor.UnaryServerInterceptor()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{})
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	if _, err := unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/ForestService/GetSharedForest"}, handler); err != nil {
+		t.Fatalf("expected public method to skip auth, got %v", err)
+	}
+	_, err = unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/ForestService/GetForest"}, handler)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated, got %v", err)
+	}
+}
@@ -0,0 +1,122 @@
+package export_test
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jdk829355/InForest_back/internal/service/export"
+	"github.com/jdk829355/InForest_back/models"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files")
+
+func fixtureForest() *models.Forest {
+	created := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	updated := time.Date(2024, 3, 2, 18, 30, 0, 0, time.UTC)
+	return &models.Forest{
+		Id:          "forest-1",
+		Name:        "Go <research>",
+		Description: "Reading list\nfor the weekend",
+		Tags:        []string{"go", "reading"},
+		CreatedAt:   created,
+		UpdatedAt:   updated,
+		Root: &models.Tree{
+			Id:        "tree-root",
+			Name:      "Go",
+			Url:       "https://go.dev/",
+			Summary:   "The Go programming language.",
+			Tags:      []string{"go"},
+			CreatedAt: created,
+			UpdatedAt: updated,
+			Children: []*models.Tree{
+				{
+					Id:             "tree-docs",
+					Name:           "Docs [official]",
+					Url:            "https://go.dev/doc/?q=a&b=(c)",
+					Summary:        "Tutorials & references.\nStart with the tour.",
+					Tags:           []string{"docs", "go"},
+					CreatedAt:      created,
+					UpdatedAt:      created,
+					FirstVisitedAt: created,
+					LastVisitedAt:  updated,
+					VisitCount:     3,
+					TotalDwellMs:   90000,
+				},
+				{
+					Id:        "tree-notes",
+					Name:      "Notes",
+					CreatedAt: created,
+					UpdatedAt: created,
+					Children: []*models.Tree{
+						{
+							Id:        "tree-blog",
+							Name:      "Blog \"generics\"",
+							Url:       "https://go.dev/blog/intro-generics",
+							CreatedAt: created,
+							UpdatedAt: created,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func fixtureMemos() map[string]string {
+	return map[string]string{
+		"tree-docs": "Read *Effective Go* first.",
+		"tree-blog": "Type parameters\nand constraints",
+	}
+}
+
+func TestWriteMatchesGoldenFiles(t *testing.T) {
+	tests := []struct {
+		golden string
+		format export.Format
+		memos  map[string]string
+	}{
+		{"forest.json", export.FormatJSON, fixtureMemos()},
+		{"forest_no_memos.json", export.FormatJSON, nil},
+		{"forest.md", export.FormatMarkdown, fixtureMemos()},
+		{"forest_no_memos.md", export.FormatMarkdown, nil},
+		{"forest.opml", export.FormatOPML, fixtureMemos()},
+		{"forest.html", export.FormatNetscape, fixtureMemos()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := export.Write(&buf, tt.format, fixtureForest(), tt.memos); err != nil {
+				t.Fatalf("unexpected error writing %s: %v", tt.format, err)
+			}
+			path := filepath.Join("testdata", tt.golden)
+			if *update {
+				if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+					t.Fatalf("unexpected error updating golden file: %v", err)
+				}
+			}
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("unexpected error reading golden file: %v", err)
+			}
+			if !bytes.Equal(buf.Bytes(), want) {
+				t.Fatalf("%s output does not match %s\ngot:\n%s\nwant:\n%s", tt.format, path, buf.Bytes(), want)
+			}
+		})
+	}
+}
+
+func TestWriteRejectsUnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	err := export.Write(&buf, export.Format("pdf"), fixtureForest(), nil)
+	if !errors.Is(err, export.ErrUnknownFormat) {
+		t.Fatalf("expected ErrUnknownFormat, got %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("expected no output, got %q", buf.String())
+	}
+}
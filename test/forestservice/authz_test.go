@@ -0,0 +1,154 @@
+package forestservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jdk829355/InForest_back/internal/grpc/forestservice"
+	"github.com/jdk829355/InForest_back/internal/store"
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOwnershipEnforced(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	other := context.WithValue(context.Background(), "user_id", "user-2")
+
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	child, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "child", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	otherForest, err := svc.CreateForest(other, &forest.CreateForestRequest{Name: "other", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	treeID := child.Tree.Id
+
+	calls := map[string]func() error{
+		"GetForest": func() error {
+			_, err := svc.GetForest(other, &forest.GetForestRequest{ForestId: created.Id})
+			return err
+		},
+		"UpdateForest": func() error {
+			_, err := svc.UpdateForest(other, &forest.UpdateForestRequest{ForestId: created.Id, Name: "stolen"})
+			return err
+		},
+		"DeleteForest": func() error {
+			_, err := svc.DeleteForest(other, &forest.DeleteForestRequest{ForestId: created.Id})
+			return err
+		},
+		"CloneForest": func() error {
+			_, err := svc.CloneForest(other, &forest.CloneForestRequest{ForestId: created.Id})
+			return err
+		},
+		"GraftForest": func() error {
+			_, err := svc.GraftForest(other, &forest.GraftForestRequest{ForestId: otherForest.Id, TargetTreeId: treeID})
+			return err
+		},
+		"CreateTree": func() error {
+			_, err := svc.CreateTree(other, &forest.CreateTreeRequest{Name: "intruder", ParentId: treeID})
+			return err
+		},
+		"GetTree": func() error {
+			_, err := svc.GetTree(other, &forest.GetTreeRequest{TreeId: treeID})
+			return err
+		},
+		"UpdateTree": func() error {
+			_, err := svc.UpdateTree(other, &forest.UpdateTreeRequest{TreeId: treeID, Name: "stolen"})
+			return err
+		},
+		"DeleteTree": func() error {
+			_, err := svc.DeleteTree(other, &forest.DeleteTreeRequest{TreeId: treeID})
+			return err
+		},
+		"MoveTree": func() error {
+			_, err := svc.MoveTree(other, &forest.MoveTreeRequest{TreeId: treeID, NewParentId: otherForest.Root.Id})
+			return err
+		},
+		"CopyTree": func() error {
+			_, err := svc.CopyTree(other, &forest.CopyTreeRequest{TreeId: treeID, TargetParentId: otherForest.Root.Id})
+			return err
+		},
+		"GetMemo": func() error {
+			_, err := svc.GetMemo(other, &forest.GetMemoRequest{TreeId: treeID})
+			return err
+		},
+		"UpdateMemo": func() error {
+			_, err := svc.UpdateMemo(other, &forest.UpdateMemoRequest{Memo: &forest.Memo{TreeId: treeID, Content: "note"}, Force: true})
+			return err
+		},
+	}
+	for name, call := range calls {
+		if code := status.Code(call()); code != codes.PermissionDenied {
+			t.Errorf("%s: expected PermissionDenied, got %v", name, code)
+		}
+	}
+
+	// 다른 사용자의 요청은 아무것도 바꾸지 않아야 함
+	got, err := svc.GetForest(ctx, &forest.GetForestRequest{ForestId: created.Id, IncludeChildren: true})
+	if err != nil {
+		t.Fatalf("unexpected error getting forest: %v", err)
+	}
+	if got.Forest.Name != "forest" || got.Forest.TotalTrees != 2 {
+		t.Fatalf("unexpected forest after denied calls: %+v", got.Forest)
+	}
+
+	_, err = svc.GetForest(ctx, &forest.GetForestRequest{ForestId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for missing forest, got %v", err)
+	}
+	_, err = svc.GetTree(ctx, &forest.GetTreeRequest{TreeId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for missing tree, got %v", err)
+	}
+}
+
+func TestMissingCallerIsUnauthenticated(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := newService(t)
+	anonymous := context.Background()
+
+	_, err := svc.GetForestsByUser(anonymous, &forest.GetForestsByUserRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("GetForestsByUser: expected Unauthenticated, got %v", err)
+	}
+	_, err = svc.CreateForest(anonymous, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("CreateForest: expected Unauthenticated, got %v", err)
+	}
+	_, err = svc.ListTrash(anonymous, &forest.ListTrashRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("ListTrash: expected Unauthenticated, got %v", err)
+	}
+	_, err = svc.PurgeTrash(anonymous, &forest.PurgeTrashRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("PurgeTrash: expected Unauthenticated, got %v", err)
+	}
+}
+
+func TestCreateForestRollsBackWhenMemoFails(t *testing.T) {
+	t.Parallel()
+
+	memos := &failingMemoStore{MemoStore: store.NewMemoryMemoStore(), failAfter: 0}
+	svc := forestservice.NewForestService(store.NewStore(store.NewMemoryForestStore(), memos))
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+
+	if _, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}}); err == nil {
+		t.Fatal("expected CreateForest to fail")
+	}
+	forests, err := svc.GetForestsByUser(ctx, &forest.GetForestsByUserRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error listing forests: %v", err)
+	}
+	if len(forests.Forests) != 0 {
+		t.Fatalf("expected forest to be rolled back, got %d forests", len(forests.Forests))
+	}
+}
@@ -0,0 +1,66 @@
+package forestservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jdk829355/InForest_back/internal/grpc/forestservice"
+	"github.com/jdk829355/InForest_back/internal/store"
+	"github.com/jdk829355/InForest_back/protos/forest"
+)
+
+func newService(t *testing.T) (*forestservice.ForestService, context.Context) {
+	t.Helper()
+
+	svc := forestservice.NewForestService(store.NewStore(store.NewMemoryForestStore(), store.NewMemoryMemoStore()))
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+	return svc, ctx
+}
+
+func TestCopyTreeCopiesMemos(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	child, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "child", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	_, err = svc.UpdateMemo(ctx, &forest.UpdateMemoRequest{Memo: &forest.Memo{TreeId: child.Tree.Id, Content: "note", Version: 0}})
+	if err != nil {
+		t.Fatalf("unexpected error updating memo: %v", err)
+	}
+
+	copied, err := svc.CopyTree(ctx, &forest.CopyTreeRequest{TreeId: child.Tree.Id, TargetParentId: created.Root.Id, IncludeMemos: true})
+	if err != nil {
+		t.Fatalf("unexpected error copying tree: %v", err)
+	}
+	if copied.Id == child.Tree.Id || copied.Name != "child" {
+		t.Fatalf("unexpected copied tree: %+v", copied)
+	}
+	memo, err := svc.GetMemo(ctx, &forest.GetMemoRequest{TreeId: copied.Id})
+	if err != nil {
+		t.Fatalf("unexpected error getting copied memo: %v", err)
+	}
+	if memo.Content != "note" || memo.Version != 0 {
+		t.Fatalf("unexpected copied memo: %+v", memo)
+	}
+
+	cloned, err := svc.CloneForest(ctx, &forest.CloneForestRequest{ForestId: created.Id})
+	if err != nil {
+		t.Fatalf("unexpected error cloning forest: %v", err)
+	}
+	if cloned.Name != "forest" || cloned.TotalTrees != 3 || cloned.Depth != 2 {
+		t.Fatalf("unexpected cloned forest: %+v", cloned)
+	}
+	memo, err = svc.GetMemo(ctx, &forest.GetMemoRequest{TreeId: cloned.Root.Id})
+	if err != nil {
+		t.Fatalf("expected an empty memo for cloned tree, got %v", err)
+	}
+	if memo.Content != "" {
+		t.Fatalf("expected empty memo without include_memos, got %+v", memo)
+	}
+}
@@ -0,0 +1,115 @@
+package forestservice_test
+
+import (
+	"testing"
+
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateTreeDuplicatePolicy(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	first, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "python", Url: "https://Python.org", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	if first.Tree.CanonicalUrl != "https://python.org/" {
+		t.Fatalf("unexpected canonical url: %q", first.Tree.CanonicalUrl)
+	}
+
+	_, err = svc.CreateTree(ctx, &forest.CreateTreeRequest{
+		Name: "again", Url: "https://python.org/?utm_source=x", ParentId: created.Root.Id, OnDuplicate: forest.DuplicatePolicy_DUPLICATE_POLICY_REJECT,
+	})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected AlreadyExists, got %v", err)
+	}
+
+	merged, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{
+		Name: "again", Url: "https://python.org/#top", ParentId: created.Root.Id, OnDuplicate: forest.DuplicatePolicy_DUPLICATE_POLICY_MERGE,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error merging tree: %v", err)
+	}
+	if !merged.Duplicate || merged.Tree.Id != first.Tree.Id || merged.Memo.GetTreeId() != first.Tree.Id {
+		t.Fatalf("expected existing tree, got %+v", merged)
+	}
+
+	// 기본 정책은 중복을 허용함
+	allowed, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "again", Url: "https://python.org/", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	if allowed.Duplicate || allowed.Tree.Id == first.Tree.Id {
+		t.Fatalf("expected new tree, got %+v", allowed)
+	}
+
+	got, err := svc.GetForest(ctx, &forest.GetForestRequest{ForestId: created.Id})
+	if err != nil {
+		t.Fatalf("unexpected error getting forest: %v", err)
+	}
+	if got.Forest.TotalTrees != 3 {
+		t.Fatalf("expected 3 trees, got %d", got.Forest.TotalTrees)
+	}
+
+	// URL을 바꾸면 정규화한 URL도 바뀜
+	updated, err := svc.UpdateTree(ctx, &forest.UpdateTreeRequest{TreeId: first.Tree.Id, Url: "https://docs.python.org/3/?gclid=1"})
+	if err != nil {
+		t.Fatalf("unexpected error updating tree: %v", err)
+	}
+	if updated.CanonicalUrl != "https://docs.python.org/3" {
+		t.Fatalf("unexpected canonical url after update: %q", updated.CanonicalUrl)
+	}
+}
+
+func TestUpdateTreeDuplicatePolicy(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	python, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "python", Url: "https://python.org", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	golang, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "go", Url: "https://go.dev", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+
+	_, err = svc.UpdateTree(ctx, &forest.UpdateTreeRequest{TreeId: golang.Tree.Id, Url: "https://python.org/?utm_source=x", OnDuplicate: forest.DuplicatePolicy_DUPLICATE_POLICY_REJECT})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected AlreadyExists, got %v", err)
+	}
+	merged, err := svc.UpdateTree(ctx, &forest.UpdateTreeRequest{TreeId: golang.Tree.Id, Url: "https://python.org/#top", OnDuplicate: forest.DuplicatePolicy_DUPLICATE_POLICY_MERGE})
+	if err != nil {
+		t.Fatalf("unexpected error merging tree: %v", err)
+	}
+	if merged.Id != python.Tree.Id {
+		t.Fatalf("expected existing tree, got %+v", merged)
+	}
+	unchanged, err := svc.GetTree(ctx, &forest.GetTreeRequest{TreeId: golang.Tree.Id})
+	if err != nil {
+		t.Fatalf("unexpected error getting tree: %v", err)
+	}
+	if unchanged.Url != "https://go.dev" {
+		t.Fatalf("expected url to stay unchanged, got %q", unchanged.Url)
+	}
+
+	// 자기 자신의 url을 다시 저장하는 것은 중복이 아님
+	same, err := svc.UpdateTree(ctx, &forest.UpdateTreeRequest{TreeId: python.Tree.Id, Name: "python docs", Url: "https://python.org/", OnDuplicate: forest.DuplicatePolicy_DUPLICATE_POLICY_REJECT})
+	if err != nil {
+		t.Fatalf("unexpected error updating tree: %v", err)
+	}
+	if same.Id != python.Tree.Id || same.Name != "python docs" {
+		t.Fatalf("unexpected updated tree: %+v", same)
+	}
+}
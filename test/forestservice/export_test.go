@@ -0,0 +1,106 @@
+package forestservice_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type exportRecorder struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks []*forest.ExportForestChunk
+}
+
+func (r *exportRecorder) Context() context.Context { return r.ctx }
+
+func (r *exportRecorder) Send(chunk *forest.ExportForestChunk) error {
+	r.chunks = append(r.chunks, chunk)
+	return nil
+}
+
+func (r *exportRecorder) data() []byte {
+	var buf bytes.Buffer
+	for _, chunk := range r.chunks {
+		buf.Write(chunk.Data)
+	}
+	return buf.Bytes()
+}
+
+func TestExportForestStreamsFormatsWithMemos(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "my/forest", Root: &forest.Tree{Name: "root", Url: "https://example.com/"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	child, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "child", Url: "https://example.com/child", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	if _, err := svc.UpdateMemo(ctx, &forest.UpdateMemoRequest{Memo: &forest.Memo{TreeId: child.Tree.Id, Content: "child note"}}); err != nil {
+		t.Fatalf("unexpected error updating memo: %v", err)
+	}
+
+	recorder := &exportRecorder{ctx: ctx}
+	if err := svc.ExportForest(&forest.ExportForestRequest{ForestId: created.Id, IncludeMemos: true}, recorder); err != nil {
+		t.Fatalf("unexpected error exporting forest: %v", err)
+	}
+	if len(recorder.chunks) == 0 || recorder.chunks[0].ContentType != "application/json" || recorder.chunks[0].Filename != "my_forest.json" {
+		t.Fatalf("unexpected first chunk: %+v", recorder.chunks)
+	}
+	var doc struct {
+		Forest struct {
+			Root struct {
+				Children []struct {
+					Id   string `json:"id"`
+					Memo string `json:"memo"`
+				} `json:"children"`
+			} `json:"root"`
+		} `json:"forest"`
+	}
+	if err := json.Unmarshal(recorder.data(), &doc); err != nil {
+		t.Fatalf("unexpected error decoding export: %v", err)
+	}
+	if children := doc.Forest.Root.Children; len(children) != 1 || children[0].Id != child.Tree.Id || children[0].Memo != "child note" {
+		t.Fatalf("unexpected exported children: %+v", children)
+	}
+
+	recorder = &exportRecorder{ctx: ctx}
+	if err := svc.ExportForest(&forest.ExportForestRequest{ForestId: created.Id, Format: forest.ExportFormat_EXPORT_FORMAT_MARKDOWN}, recorder); err != nil {
+		t.Fatalf("unexpected error exporting forest: %v", err)
+	}
+	markdown := string(recorder.data())
+	if recorder.chunks[0].Filename != "my_forest.md" || !strings.Contains(markdown, "  - [child](https://example.com/child)\n") || strings.Contains(markdown, "child note") {
+		t.Fatalf("unexpected markdown export %q", markdown)
+	}
+}
+
+func TestExportForestRejectsUnknownFormatAndStrangers(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+
+	err = svc.ExportForest(&forest.ExportForestRequest{ForestId: created.Id, Format: forest.ExportFormat(99)}, &exportRecorder{ctx: ctx})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+
+	stranger := context.WithValue(context.Background(), "user_id", "user-2")
+	err = svc.ExportForest(&forest.ExportForestRequest{ForestId: created.Id}, &exportRecorder{ctx: stranger})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied, got %v", err)
+	}
+}
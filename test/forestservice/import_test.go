@@ -0,0 +1,219 @@
+package forestservice_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/jdk829355/InForest_back/internal/grpc/forestservice"
+	"github.com/jdk829355/InForest_back/internal/store"
+	"github.com/jdk829355/InForest_back/models"
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc"
+)
+
+type historyStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*forest.ImportHistoryRequest
+	report   *forest.ImportHistoryReport
+}
+
+func (s *historyStream) Context() context.Context { return s.ctx }
+
+func (s *historyStream) Recv() (*forest.ImportHistoryRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *historyStream) SendAndClose(report *forest.ImportHistoryReport) error {
+	s.report = report
+	return nil
+}
+
+func historyRecord(url, title, visitedAt, referrer string, transition forest.HistoryTransition) *forest.ImportHistoryRequest {
+	return &forest.ImportHistoryRequest{Payload: &forest.ImportHistoryRequest_Record{Record: &forest.HistoryRecord{
+		Url: url, Title: title, VisitedAt: visitedAt, Referrer: referrer, Transition: transition, DwellMs: 1000,
+	}}}
+}
+
+func TestImportHistory(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	link := forest.HistoryTransition_HISTORY_TRANSITION_LINK
+	typed := forest.HistoryTransition_HISTORY_TRANSITION_TYPED
+	stream := &historyStream{ctx: ctx, requests: []*forest.ImportHistoryRequest{
+		{Payload: &forest.ImportHistoryRequest_Options{Options: &forest.ImportHistoryOptions{SessionGapMinutes: 60}}},
+		// 첫 번째 세션: search → docs → tutorial, 그리고 다시 search
+		historyRecord("https://search.example/?q=go", "Search", "2026-03-01T09:00:00Z", "", typed),
+		historyRecord("https://go.dev/doc", "Docs", "2026-03-01T09:05:00Z", "https://search.example/?q=go", link),
+		historyRecord("https://go.dev/doc/tutorial", "", "2026-03-01T09:10:00Z", "https://go.dev/doc", link),
+		historyRecord("https://search.example/?q=go&utm_source=x", "Search", "2026-03-01T09:20:00Z", "", typed),
+		historyRecord("not a url", "bad", "2026-03-01T09:21:00Z", "", link),
+		historyRecord("https://go.dev/blog", "Blog", "yesterday", "", link),
+		// 두 번째 세션: 시작 트리가 둘이라 세션 루트 아래에 둠
+		historyRecord("https://news.example", "News", "2026-03-01T12:00:00Z", "", typed),
+		historyRecord("https://mail.example", "Mail", "2026-03-01T12:10:00Z", "https://news.example", typed),
+		historyRecord("https://ads.example/frame", "", "2026-03-01T12:11:00Z", "", forest.HistoryTransition_HISTORY_TRANSITION_SUBFRAME),
+	}}
+	if err := svc.ImportHistory(stream); err != nil {
+		t.Fatalf("unexpected error importing history: %v", err)
+	}
+	report := stream.report
+	if len(report.Forests) != 2 || report.TreesCreated != 6 || report.VisitsRecorded != 6 || report.RecordsSkipped != 3 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	reasons := map[int32]string{}
+	for _, skipped := range report.Skipped {
+		reasons[skipped.Index] = skipped.Reason
+	}
+	if reasons[4] != "invalid url" || reasons[5] != "invalid visited_at" || reasons[8] != "subframe navigation" {
+		t.Fatalf("unexpected skipped records: %+v", report.Skipped)
+	}
+
+	first, err := svc.GetForest(ctx, &forest.GetForestRequest{ForestId: report.Forests[0].Id, IncludeChildren: true})
+	if err != nil {
+		t.Fatalf("unexpected error getting forest: %v", err)
+	}
+	root := first.Forest.Root
+	if root.Name != "Search" || root.VisitCount != 2 || root.TotalDwellMs != 2000 || first.Forest.Depth != 3 || first.Forest.TotalTrees != 3 {
+		t.Fatalf("unexpected first forest: %+v", first.Forest)
+	}
+	if len(root.Children) != 1 || root.Children[0].Name != "Docs" ||
+		len(root.Children[0].Children) != 1 || root.Children[0].Children[0].Name != "https://go.dev/doc/tutorial" {
+		t.Fatalf("unexpected referrer chain: %+v", root)
+	}
+	if _, err := svc.GetMemo(ctx, &forest.GetMemoRequest{TreeId: root.Children[0].Children[0].Id}); err != nil {
+		t.Fatalf("expected memo for imported tree: %v", err)
+	}
+
+	second, err := svc.GetForest(ctx, &forest.GetForestRequest{ForestId: report.Forests[1].Id, IncludeChildren: true})
+	if err != nil {
+		t.Fatalf("unexpected error getting forest: %v", err)
+	}
+	root = second.Forest.Root
+	if root.Url != "" || len(root.Children) != 2 || root.Children[0].Name != "News" || root.Children[1].Name != "Mail" {
+		t.Fatalf("expected session root with two start trees, got %+v", root)
+	}
+	if _, err := svc.GetMemo(ctx, &forest.GetMemoRequest{TreeId: root.Id}); err != nil {
+		t.Fatalf("expected memo for session root: %v", err)
+	}
+}
+
+func TestImportHistoryLimit(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	// 한도(10000개)를 넘은 기록은 건너뛴 수에만 셈
+	const total = 10002
+	base := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	stream := &historyStream{ctx: ctx}
+	for i := 0; i < total; i++ {
+		visitedAt := base.Add(time.Duration(i) * time.Second).Format(time.RFC3339)
+		stream.requests = append(stream.requests, historyRecord("https://go.dev/doc", "Docs", visitedAt, "", forest.HistoryTransition_HISTORY_TRANSITION_TYPED))
+	}
+	if err := svc.ImportHistory(stream); err != nil {
+		t.Fatalf("unexpected error importing history: %v", err)
+	}
+	report := stream.report
+	if report.VisitsRecorded != 10000 || report.RecordsSkipped != 2 || len(report.Skipped) != 0 {
+		t.Fatalf("unexpected report: visits=%d skipped=%d list=%d", report.VisitsRecorded, report.RecordsSkipped, len(report.Skipped))
+	}
+}
+
+// failingMemoStore는 failAfter번째 이후의 CreateMemo를 실패시킵니다.
+type failingMemoStore struct {
+	store.MemoStore
+	failAfter int
+	created   int
+}
+
+func (s *failingMemoStore) CreateMemo(ctx context.Context, userID string, treeID string, options map[string]interface{}) (*models.Memo, error) {
+	if s.created >= s.failAfter {
+		return nil, errors.New("memo store unavailable")
+	}
+	s.created++
+	return s.MemoStore.CreateMemo(ctx, userID, treeID, options)
+}
+
+func TestImportHistoryRollsBackOnFailure(t *testing.T) {
+	t.Parallel()
+
+	memos := &failingMemoStore{MemoStore: store.NewMemoryMemoStore(), failAfter: 1}
+	svc := forestservice.NewForestService(store.NewStore(store.NewMemoryForestStore(), memos))
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+	typed := forest.HistoryTransition_HISTORY_TRANSITION_TYPED
+	// 첫 번째 세션은 저장되고 두 번째 세션에서 메모 생성이 실패함
+	stream := &historyStream{ctx: ctx, requests: []*forest.ImportHistoryRequest{
+		historyRecord("https://go.dev/doc", "Docs", "2026-03-01T09:00:00Z", "", typed),
+		historyRecord("https://news.example", "News", "2026-03-01T12:00:00Z", "", typed),
+	}}
+	if err := svc.ImportHistory(stream); err == nil {
+		t.Fatal("expected import to fail")
+	}
+	forests, err := svc.GetForestsByUser(ctx, &forest.GetForestsByUserRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error listing forests: %v", err)
+	}
+	if len(forests.Forests) != 0 {
+		t.Fatalf("expected earlier sessions to be rolled back, got %d forests", len(forests.Forests))
+	}
+}
+
+// cancelingMemoStore는 failAfter번째 이후의 CreateMemo에서 클라이언트 취소를 흉내 냅니다.
+type cancelingMemoStore struct {
+	failingMemoStore
+	cancel context.CancelFunc
+}
+
+func (s *cancelingMemoStore) CreateMemo(ctx context.Context, userID string, treeID string, options map[string]interface{}) (*models.Memo, error) {
+	if s.created >= s.failAfter {
+		s.cancel()
+		return nil, ctx.Err()
+	}
+	return s.failingMemoStore.CreateMemo(ctx, userID, treeID, options)
+}
+
+// cancelAwareForestStore는 실제 DB 드라이버처럼 취소된 컨텍스트로는 숲을 지우지 않습니다.
+type cancelAwareForestStore struct {
+	*store.MemoryForestStore
+}
+
+func (s *cancelAwareForestStore) PurgeForest(ctx context.Context, forestID string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.MemoryForestStore.PurgeForest(ctx, forestID)
+}
+
+func TestImportHistoryRollsBackAfterCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), "user_id", "user-1"))
+	defer cancel()
+	memos := &cancelingMemoStore{failingMemoStore: failingMemoStore{MemoStore: store.NewMemoryMemoStore(), failAfter: 1}, cancel: cancel}
+	forests := &cancelAwareForestStore{MemoryForestStore: store.NewMemoryForestStore()}
+	svc := forestservice.NewForestService(store.NewStore(forests, memos))
+	typed := forest.HistoryTransition_HISTORY_TRANSITION_TYPED
+	stream := &historyStream{ctx: ctx, requests: []*forest.ImportHistoryRequest{
+		historyRecord("https://go.dev/doc", "Docs", "2026-03-01T09:00:00Z", "", typed),
+		historyRecord("https://news.example", "News", "2026-03-01T12:00:00Z", "", typed),
+	}}
+	if err := svc.ImportHistory(stream); err == nil {
+		t.Fatal("expected import to fail")
+	}
+	listed, _, err := forests.GetForestByUser(context.Background(), "user-1", store.ForestListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error listing forests: %v", err)
+	}
+	if len(listed) != 0 {
+		t.Fatalf("expected imported forests to be purged after cancel, got %d forests", len(listed))
+	}
+}
@@ -0,0 +1,205 @@
+package forestservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	other := context.WithValue(context.Background(), "user_id", "user-2")
+
+	own, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "own", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	named, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "Graph Databases", Url: "https://example.com/graph", ParentId: own.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	noted, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "reading list", ParentId: own.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	if _, err := svc.UpdateMemo(ctx, &forest.UpdateMemoRequest{Memo: &forest.Memo{TreeId: noted.Tree.Id, Content: "compare graph engines"}}); err != nil {
+		t.Fatalf("unexpected error updating memo: %v", err)
+	}
+	trashed, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "graph trash", ParentId: own.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	if _, err := svc.DeleteTree(ctx, &forest.DeleteTreeRequest{TreeId: trashed.Tree.Id}); err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+
+	shared, err := svc.CreateForest(other, &forest.CreateForestRequest{Name: "shared", Root: &forest.Tree{Name: "graph theory"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	if _, err := svc.CreateForest(other, &forest.CreateForestRequest{Name: "private", Root: &forest.Tree{Name: "graph secrets"}}); err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	if _, err := svc.ShareForest(other, &forest.ShareForestRequest{ForestId: shared.Id, UserId: "user-1", Role: forest.ForestRole_FOREST_ROLE_VIEWER}); err != nil {
+		t.Fatalf("unexpected error sharing forest: %v", err)
+	}
+
+	resp, err := svc.Search(ctx, &forest.SearchRequest{Query: "GRAPH"})
+	if err != nil {
+		t.Fatalf("unexpected error searching: %v", err)
+	}
+	if len(resp.Hits) != 3 {
+		t.Fatalf("expected 3 hits, got %+v", resp.Hits)
+	}
+	// 이름과 URL에서 모두 찾은 트리가 가장 앞에 옴
+	top := resp.Hits[0]
+	if top.TreeId != named.Tree.Id || top.ForestId != own.Id || top.Source != forest.MatchSource_MATCH_SOURCE_NAME ||
+		top.Snippet != "<em>Graph</em> Databases" {
+		t.Fatalf("unexpected top hit: %+v", top)
+	}
+	sources := map[string]forest.MatchSource{}
+	for _, hit := range resp.Hits {
+		sources[hit.TreeId] = hit.Source
+	}
+	if sources[noted.Tree.Id] != forest.MatchSource_MATCH_SOURCE_MEMO || sources[shared.Root.Id] != forest.MatchSource_MATCH_SOURCE_NAME {
+		t.Fatalf("expected memo hit and shared forest hit, got %+v", resp.Hits)
+	}
+
+	owned, err := svc.Search(ctx, &forest.SearchRequest{Query: "graph", Scope: forest.SearchScope_SEARCH_SCOPE_OWNED})
+	if err != nil {
+		t.Fatalf("unexpected error searching: %v", err)
+	}
+	if len(owned.Hits) != 2 {
+		t.Fatalf("expected 2 hits in owned forests, got %+v", owned.Hits)
+	}
+
+	// 모든 단어가 있어야 함
+	both, err := svc.Search(ctx, &forest.SearchRequest{Query: "graph engines", ForestId: own.Id})
+	if err != nil {
+		t.Fatalf("unexpected error searching: %v", err)
+	}
+	if len(both.Hits) != 1 || both.Hits[0].Snippet != "compare <em>graph</em> <em>engines</em>" {
+		t.Fatalf("unexpected hits: %+v", both.Hits)
+	}
+
+	_, err = svc.Search(other, &forest.SearchRequest{Query: "graph", ForestId: own.Id})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied searching another user's forest, got %v", err)
+	}
+	_, err = svc.Search(ctx, &forest.SearchRequest{Query: "   "})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for empty query, got %v", err)
+	}
+}
+
+func TestSearchMemosSkipsTrashedCandidates(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	bin, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "bin", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	// 휴지통에 있는 메모가 후보 수(limit × 5)보다 많아도 범위 안의 메모를 찾아야 함
+	for range 40 {
+		trashed, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "page", ParentId: bin.Tree.Id})
+		if err != nil {
+			t.Fatalf("unexpected error creating tree: %v", err)
+		}
+		if _, err := svc.UpdateMemo(ctx, &forest.UpdateMemoRequest{Memo: &forest.Memo{TreeId: trashed.Tree.Id, Content: "needle"}}); err != nil {
+			t.Fatalf("unexpected error updating memo: %v", err)
+		}
+	}
+	if _, err := svc.DeleteTree(ctx, &forest.DeleteTreeRequest{TreeId: bin.Tree.Id, Cascade: true}); err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+	live, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "live", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	if _, err := svc.UpdateMemo(ctx, &forest.UpdateMemoRequest{Memo: &forest.Memo{TreeId: live.Tree.Id, Content: "needle"}}); err != nil {
+		t.Fatalf("unexpected error updating memo: %v", err)
+	}
+
+	resp, err := svc.Search(ctx, &forest.SearchRequest{Query: "needle", Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error searching: %v", err)
+	}
+	if len(resp.Hits) != 1 || resp.Hits[0].TreeId != live.Tree.Id {
+		t.Fatalf("expected live memo hit, got %+v", resp.Hits)
+	}
+}
+
+func TestSearchEscapesSnippetHTML(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	if _, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: `<b onclick="x">graph</b> & co`, ParentId: created.Root.Id}); err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+
+	resp, err := svc.Search(ctx, &forest.SearchRequest{Query: "graph"})
+	if err != nil {
+		t.Fatalf("unexpected error searching: %v", err)
+	}
+	want := `&lt;b onclick=&#34;x&#34;&gt;<em>graph</em>&lt;/b&gt; &amp; co`
+	if len(resp.Hits) != 1 || resp.Hits[0].Snippet != want {
+		t.Fatalf("expected escaped snippet %q, got %+v", want, resp.Hits)
+	}
+}
+
+func TestSearchRanksMemosByRelevance(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	memos := []string{
+		"a long note that only mentions rust once near the end",
+		"rust ownership, rust lifetimes and rust traits",
+		"rust at the start",
+	}
+	treeIDs := make([]string, len(memos))
+	for i, content := range memos {
+		tree, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "page", ParentId: created.Root.Id})
+		if err != nil {
+			t.Fatalf("unexpected error creating tree: %v", err)
+		}
+		treeIDs[i] = tree.Tree.Id
+		if _, err := svc.UpdateMemo(ctx, &forest.UpdateMemoRequest{Memo: &forest.Memo{TreeId: tree.Tree.Id, Content: content}}); err != nil {
+			t.Fatalf("unexpected error updating memo: %v", err)
+		}
+	}
+
+	resp, err := svc.Search(ctx, &forest.SearchRequest{Query: "rust"})
+	if err != nil {
+		t.Fatalf("unexpected error searching: %v", err)
+	}
+	if len(resp.Hits) != 3 {
+		t.Fatalf("expected 3 hits, got %+v", resp.Hits)
+	}
+	// 여러 번 나온 메모, 앞에서 나온 메모, 뒤에서 한 번 나온 메모 순
+	for i, want := range []string{treeIDs[1], treeIDs[2], treeIDs[0]} {
+		if resp.Hits[i].TreeId != want {
+			t.Fatalf("unexpected memo ranking: %+v", resp.Hits)
+		}
+	}
+	if resp.Hits[0].Score <= resp.Hits[1].Score || resp.Hits[1].Score <= resp.Hits[2].Score {
+		t.Fatalf("expected strictly decreasing scores, got %+v", resp.Hits)
+	}
+}
@@ -0,0 +1,137 @@
+package forestservice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestShareLinks(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	anonymous := context.Background()
+	other := context.WithValue(context.Background(), "user_id", "user-2")
+
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	if _, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "child", ParentId: created.Root.Id}); err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+
+	_, err = svc.CreateShareLink(other, &forest.CreateShareLinkRequest{ForestId: created.Id})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied for non-owner, got %v", err)
+	}
+	_, err = svc.CreateShareLink(ctx, &forest.CreateShareLinkRequest{ForestId: created.Id, ExpiresAt: time.Now().Add(-time.Hour).Format(time.RFC3339)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for past expiry, got %v", err)
+	}
+
+	link, err := svc.CreateShareLink(ctx, &forest.CreateShareLinkRequest{ForestId: created.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating share link: %v", err)
+	}
+	if len(link.Token) < 32 {
+		t.Fatalf("expected unguessable token, got %q", link.Token)
+	}
+
+	shared, err := svc.GetSharedForest(anonymous, &forest.GetSharedForestRequest{Token: link.Token})
+	if err != nil {
+		t.Fatalf("unexpected error getting shared forest: %v", err)
+	}
+	if shared.Forest.Id != created.Id || shared.Forest.UserId != "" || len(shared.Forest.Root.GetChildren()) != 1 {
+		t.Fatalf("unexpected shared forest: %+v", shared.Forest)
+	}
+	if !shared.ExpiresAt.AsTime().Equal(link.ExpiresAt.AsTime()) || !link.ExpiresAt.AsTime().After(link.CreatedAt.AsTime()) {
+		t.Fatalf("unexpected share link times: expires_at=%v created_at=%v shared expires_at=%v", link.ExpiresAt, link.CreatedAt, shared.ExpiresAt)
+	}
+
+	links, err := svc.ListShareLinks(ctx, &forest.ListShareLinksRequest{ForestId: created.Id})
+	if err != nil {
+		t.Fatalf("unexpected error listing share links: %v", err)
+	}
+	if len(links.Links) != 1 || links.Links[0].Id != link.Id || links.Links[0].Token != "" {
+		t.Fatalf("unexpected share links: %+v", links.Links)
+	}
+
+	if _, err := svc.RevokeShareLink(ctx, &forest.RevokeShareLinkRequest{ForestId: created.Id, LinkId: link.Id}); err != nil {
+		t.Fatalf("unexpected error revoking share link: %v", err)
+	}
+	_, err = svc.GetSharedForest(anonymous, &forest.GetSharedForestRequest{Token: link.Token})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for revoked link, got %v", err)
+	}
+	_, err = svc.GetSharedForest(anonymous, &forest.GetSharedForestRequest{Token: "guess"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for unknown token, got %v", err)
+	}
+}
+
+func TestShareLinkExpires(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	link, err := svc.CreateShareLink(ctx, &forest.CreateShareLinkRequest{ForestId: created.Id, ExpiresAt: time.Now().Add(time.Second).Format(time.RFC3339)})
+	if err != nil {
+		t.Fatalf("unexpected error creating share link: %v", err)
+	}
+	time.Sleep(1100 * time.Millisecond)
+
+	_, err = svc.GetSharedForest(context.Background(), &forest.GetSharedForestRequest{Token: link.Token})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for expired link, got %v", err)
+	}
+}
+
+func TestSharedForestHidesVisitsAndTags(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root", Url: "https://example.com"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	child, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "child", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	for _, treeID := range []string{created.Root.Id, child.Tree.Id} {
+		if _, err := svc.RecordVisit(ctx, &forest.RecordVisitRequest{TreeId: treeID, DwellMs: 1000}); err != nil {
+			t.Fatalf("unexpected error recording visit: %v", err)
+		}
+		if _, err := svc.AddTags(ctx, &forest.TagsRequest{TreeId: treeID, Tags: []string{"private"}}); err != nil {
+			t.Fatalf("unexpected error adding tags: %v", err)
+		}
+	}
+	if _, err := svc.AddTags(ctx, &forest.TagsRequest{ForestId: created.Id, Tags: []string{"private"}}); err != nil {
+		t.Fatalf("unexpected error adding tags: %v", err)
+	}
+	link, err := svc.CreateShareLink(ctx, &forest.CreateShareLinkRequest{ForestId: created.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating share link: %v", err)
+	}
+
+	shared, err := svc.GetSharedForest(context.Background(), &forest.GetSharedForestRequest{Token: link.Token})
+	if err != nil {
+		t.Fatalf("unexpected error getting shared forest: %v", err)
+	}
+	if len(shared.Forest.Tags) != 0 {
+		t.Fatalf("expected no forest tags, got %v", shared.Forest.Tags)
+	}
+	for _, tree := range []*forest.Tree{shared.Forest.Root, shared.Forest.Root.GetChildren()[0]} {
+		if tree.VisitCount != 0 || tree.TotalDwellMs != 0 || tree.FirstVisitedAt != nil || tree.LastVisitedAt != nil || len(tree.Tags) != 0 {
+			t.Fatalf("expected private fields to be stripped, got %+v", tree)
+		}
+	}
+}
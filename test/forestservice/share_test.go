@@ -0,0 +1,108 @@
+package forestservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestShareForestRoles(t *testing.T) {
+	t.Parallel()
+
+	svc, owner := newService(t)
+	viewer := context.WithValue(context.Background(), "user_id", "viewer")
+	editor := context.WithValue(context.Background(), "user_id", "editor")
+
+	created, err := svc.CreateForest(owner, &forest.CreateForestRequest{Name: "research", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	for userID, role := range map[string]forest.ForestRole{"viewer": forest.ForestRole_FOREST_ROLE_VIEWER, "editor": forest.ForestRole_FOREST_ROLE_EDITOR} {
+		if _, err := svc.ShareForest(owner, &forest.ShareForestRequest{ForestId: created.Id, UserId: userID, Role: role}); err != nil {
+			t.Fatalf("unexpected error sharing forest with %s: %v", userID, err)
+		}
+	}
+	_, err = svc.ShareForest(owner, &forest.ShareForestRequest{ForestId: created.Id, UserId: "user-1", Role: forest.ForestRole_FOREST_ROLE_EDITOR})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument sharing with owner, got %v", err)
+	}
+
+	// viewer는 조회만 가능
+	if _, err := svc.GetForest(viewer, &forest.GetForestRequest{ForestId: created.Id}); err != nil {
+		t.Fatalf("viewer should read forest: %v", err)
+	}
+	_, err = svc.CreateTree(viewer, &forest.CreateTreeRequest{Name: "child", ParentId: created.Root.Id})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied for viewer CreateTree, got %v", err)
+	}
+
+	// editor는 트리와 메모를 편집할 수 있고 메모는 소유자와 공유됨
+	child, err := svc.CreateTree(editor, &forest.CreateTreeRequest{Name: "child", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("editor should create tree: %v", err)
+	}
+	if _, err := svc.UpdateMemo(editor, &forest.UpdateMemoRequest{Memo: &forest.Memo{TreeId: child.Tree.Id, Content: "note", Version: 0}}); err != nil {
+		t.Fatalf("editor should update memo: %v", err)
+	}
+	memo, err := svc.GetMemo(owner, &forest.GetMemoRequest{TreeId: child.Tree.Id})
+	if err != nil {
+		t.Fatalf("unexpected error getting memo: %v", err)
+	}
+	if memo.Content != "note" {
+		t.Fatalf("expected shared memo, got %+v", memo)
+	}
+	_, err = svc.UpdateMemo(viewer, &forest.UpdateMemoRequest{Memo: &forest.Memo{TreeId: child.Tree.Id, Content: "x"}, Force: true})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied for viewer UpdateMemo, got %v", err)
+	}
+
+	// 숲 삭제와 공유는 소유자만 가능
+	_, err = svc.DeleteForest(editor, &forest.DeleteForestRequest{ForestId: created.Id})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied for editor DeleteForest, got %v", err)
+	}
+	_, err = svc.ShareForest(editor, &forest.ShareForestRequest{ForestId: created.Id, UserId: "someone", Role: forest.ForestRole_FOREST_ROLE_VIEWER})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied for editor ShareForest, got %v", err)
+	}
+
+	members, err := svc.ListForestMembers(viewer, &forest.ListForestMembersRequest{ForestId: created.Id})
+	if err != nil {
+		t.Fatalf("unexpected error listing members: %v", err)
+	}
+	if len(members.Members) != 3 || members.Members[0].UserId != "user-1" || members.Members[0].Role != forest.ForestRole_FOREST_ROLE_OWNER ||
+		members.Members[1].UserId != "editor" || members.Members[2].UserId != "viewer" {
+		t.Fatalf("unexpected members: %+v", members.Members)
+	}
+
+	shared, err := svc.GetForestsByUser(viewer, &forest.GetForestsByUserRequest{IncludeShared: true})
+	if err != nil {
+		t.Fatalf("unexpected error listing forests: %v", err)
+	}
+	if len(shared.Forests) != 1 || shared.Forests[0].Id != created.Id {
+		t.Fatalf("expected shared forest in list, got %+v", shared.Forests)
+	}
+	own, err := svc.GetForestsByUser(viewer, &forest.GetForestsByUserRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error listing forests: %v", err)
+	}
+	if len(own.Forests) != 0 {
+		t.Fatalf("expected no own forests, got %+v", own.Forests)
+	}
+
+	// 멤버는 스스로 나갈 수 있음
+	if _, err := svc.UnshareForest(viewer, &forest.UnshareForestRequest{ForestId: created.Id, UserId: "viewer"}); err != nil {
+		t.Fatalf("viewer should leave forest: %v", err)
+	}
+	_, err = svc.GetForest(viewer, &forest.GetForestRequest{ForestId: created.Id})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied after unshare, got %v", err)
+	}
+	_, err = svc.UnshareForest(editor, &forest.UnshareForestRequest{ForestId: created.Id, UserId: "viewer"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied for editor unsharing others, got %v", err)
+	}
+}
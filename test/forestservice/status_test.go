@@ -0,0 +1,172 @@
+package forestservice_test
+
+import (
+	"testing"
+
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMoveTreeInvalidMoveStatus(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	child, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "child", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	grandchild, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "grandchild", ParentId: child.Tree.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+
+	_, err = svc.MoveTree(ctx, &forest.MoveTreeRequest{TreeId: child.Tree.Id, NewParentId: grandchild.Tree.Id})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition moving into descendant, got %v", err)
+	}
+	_, err = svc.MoveTree(ctx, &forest.MoveTreeRequest{TreeId: created.Root.Id, NewParentId: child.Tree.Id})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition moving root tree, got %v", err)
+	}
+}
+
+func TestDeleteRootWithMultipleChildrenStatus(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	for _, name := range []string{"a", "b"} {
+		if _, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: name, ParentId: created.Root.Id}); err != nil {
+			t.Fatalf("unexpected error creating tree: %v", err)
+		}
+	}
+
+	_, err = svc.DeleteTree(ctx, &forest.DeleteTreeRequest{TreeId: created.Root.Id})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition deleting root with several children, got %v", err)
+	}
+	_, err = svc.SplitForest(ctx, &forest.SplitForestRequest{TreeId: created.Root.Id, Name: "split"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition splitting at root, got %v", err)
+	}
+}
+
+func TestRestoreStatus(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	parent, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "parent", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	child, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "child", ParentId: parent.Tree.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+
+	_, err = svc.RestoreTree(ctx, &forest.RestoreTreeRequest{TreeId: child.Tree.Id})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition restoring live tree, got %v", err)
+	}
+	_, err = svc.RestoreForest(ctx, &forest.RestoreForestRequest{ForestId: created.Id})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition restoring live forest, got %v", err)
+	}
+
+	// 부모보다 자식을 먼저 휴지통에 넣은 뒤 부모도 넣으면 자식은 복원할 수 없음
+	if _, err := svc.DeleteTree(ctx, &forest.DeleteTreeRequest{TreeId: child.Tree.Id, Cascade: true}); err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+	if _, err := svc.DeleteTree(ctx, &forest.DeleteTreeRequest{TreeId: parent.Tree.Id, Cascade: true}); err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+	_, err = svc.RestoreTree(ctx, &forest.RestoreTreeRequest{TreeId: child.Tree.Id})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition restoring under trashed parent, got %v", err)
+	}
+
+	trash, err := svc.ListTrash(ctx, &forest.ListTrashRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error listing trash: %v", err)
+	}
+	for _, item := range trash.Items {
+		if item.DeletedAt == nil || item.DeletedAt.AsTime().IsZero() {
+			t.Fatalf("expected deleted_at on trash item, got %+v", item)
+		}
+	}
+}
+
+func TestReorderChildrenInvalidOrderStatus(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	a, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "a", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	if _, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "b", ParentId: created.Root.Id}); err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+
+	_, err = svc.ReorderChildren(ctx, &forest.ReorderChildrenRequest{ParentId: created.Root.Id, OrderedChildIds: []string{a.Tree.Id}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for incomplete order, got %v", err)
+	}
+}
+
+func TestInvalidPageTokenStatus(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+
+	_, err = svc.GetForestsByUser(ctx, &forest.GetForestsByUserRequest{PageToken: "not-a-token"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument listing forests, got %v", err)
+	}
+	_, err = svc.ListChildren(ctx, &forest.ListChildrenRequest{ParentId: created.Root.Id, PageToken: "not-a-token"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument listing children, got %v", err)
+	}
+}
+
+func TestMissingMemoStatus(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	if _, err := svc.Store.Memo.DeleteMemo(ctx, "user-1", created.Root.Id); err != nil {
+		t.Fatalf("unexpected error deleting memo: %v", err)
+	}
+
+	_, err = svc.GetMemo(ctx, &forest.GetMemoRequest{TreeId: created.Root.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound getting missing memo, got %v", err)
+	}
+	_, err = svc.UpdateMemo(ctx, &forest.UpdateMemoRequest{Memo: &forest.Memo{TreeId: created.Root.Id, Content: "note"}, Force: true})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound updating missing memo, got %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+package forestservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jdk829355/InForest_back/internal/grpc/forestservice"
+	"github.com/jdk829355/InForest_back/internal/store"
+	"github.com/jdk829355/InForest_back/models"
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type chunkRecorder struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks []*forest.StreamForestChunk
+}
+
+func (r *chunkRecorder) Context() context.Context { return r.ctx }
+
+func (r *chunkRecorder) Send(chunk *forest.StreamForestChunk) error {
+	r.chunks = append(r.chunks, chunk)
+	return nil
+}
+
+func TestStreamForestSendsBreadthFirstChunks(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	a, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "a", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	if _, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "a1", ParentId: a.Tree.Id}); err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	if _, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "b", ParentId: created.Root.Id}); err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+
+	recorder := &chunkRecorder{ctx: ctx}
+	if err := svc.StreamForest(&forest.StreamForestRequest{ForestId: created.Id, ChunkSize: 3}, recorder); err != nil {
+		t.Fatalf("unexpected error streaming forest: %v", err)
+	}
+	if len(recorder.chunks) != 2 || recorder.chunks[0].Forest.GetId() != created.Id || recorder.chunks[1].Forest != nil {
+		t.Fatalf("expected two chunks with forest info in the first, got %+v", recorder.chunks)
+	}
+
+	var names, parents []string
+	for _, chunk := range recorder.chunks {
+		for _, node := range chunk.Nodes {
+			names = append(names, node.Tree.Name)
+			parents = append(parents, node.ParentId)
+		}
+	}
+	expected := []string{"root", "a", "b", "a1"}
+	for i := range expected {
+		if i >= len(names) || names[i] != expected[i] {
+			t.Fatalf("expected breadth-first order %v, got %v", expected, names)
+		}
+	}
+	if parents[0] != "" || parents[1] != created.Root.Id || parents[3] != a.Tree.Id {
+		t.Fatalf("unexpected parent ids: %v", parents)
+	}
+}
+
+func TestStreamForestFollowsParentSiblingOrder(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	// 여러 부모의 자식이 부모 ID 순이 아니라 부모의 너비 우선 순서대로 나와야 함
+	var parentIDs []string
+	for _, name := range []string{"p0", "p1", "p2", "p3"} {
+		parent, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: name, ParentId: created.Root.Id})
+		if err != nil {
+			t.Fatalf("unexpected error creating tree: %v", err)
+		}
+		parentIDs = append(parentIDs, parent.Tree.Id)
+		for _, child := range []string{"x", "y"} {
+			if _, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: name + child, ParentId: parent.Tree.Id}); err != nil {
+				t.Fatalf("unexpected error creating tree: %v", err)
+			}
+		}
+	}
+	reversed := []string{parentIDs[3], parentIDs[2], parentIDs[1], parentIDs[0]}
+	if _, err := svc.ReorderChildren(ctx, &forest.ReorderChildrenRequest{ParentId: created.Root.Id, OrderedChildIds: reversed}); err != nil {
+		t.Fatalf("unexpected error reordering children: %v", err)
+	}
+
+	recorder := &chunkRecorder{ctx: ctx}
+	if err := svc.StreamForest(&forest.StreamForestRequest{ForestId: created.Id}, recorder); err != nil {
+		t.Fatalf("unexpected error streaming forest: %v", err)
+	}
+	var names []string
+	for _, chunk := range recorder.chunks {
+		for _, node := range chunk.Nodes {
+			names = append(names, node.Tree.Name)
+		}
+	}
+	expected := []string{"root", "p3", "p2", "p1", "p0", "p3x", "p3y", "p2x", "p2y", "p1x", "p1y", "p0x", "p0y"}
+	if len(names) != len(expected) {
+		t.Fatalf("expected breadth-first order %v, got %v", expected, names)
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Fatalf("expected breadth-first order %v, got %v", expected, names)
+		}
+	}
+}
+
+// trashedDuringWalkStore는 숲을 읽은 뒤 순회하기 전에 숲이 휴지통으로 옮겨진 상황을 흉내 냅니다.
+type trashedDuringWalkStore struct {
+	*store.MemoryForestStore
+}
+
+func (s *trashedDuringWalkStore) WalkForest(ctx context.Context, forestID string, visit func(node *models.TreeNode) error) error {
+	return store.ErrForestNotFound
+}
+
+func TestStreamForestMapsWalkErrors(t *testing.T) {
+	t.Parallel()
+
+	svc := forestservice.NewForestService(store.NewStore(&trashedDuringWalkStore{MemoryForestStore: store.NewMemoryForestStore()}, store.NewMemoryMemoStore()))
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+
+	err = svc.StreamForest(&forest.StreamForestRequest{ForestId: created.Id}, &chunkRecorder{ctx: ctx})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+}
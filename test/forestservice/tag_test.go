@@ -0,0 +1,105 @@
+package forestservice_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTags(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	editor := context.WithValue(context.Background(), "user_id", "editor")
+
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	child, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "child", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	if _, err := svc.ShareForest(ctx, &forest.ShareForestRequest{ForestId: created.Id, UserId: "editor", Role: forest.ForestRole_FOREST_ROLE_EDITOR}); err != nil {
+		t.Fatalf("unexpected error sharing forest: %v", err)
+	}
+
+	tags, err := svc.AddTags(ctx, &forest.TagsRequest{TreeId: child.Tree.Id, Tags: []string{" go ", "db", "go"}})
+	if err != nil {
+		t.Fatalf("unexpected error adding tags: %v", err)
+	}
+	if !slices.Equal(tags.Tags, []string{"db", "go"}) {
+		t.Fatalf("expected trimmed, deduplicated tags, got %v", tags.Tags)
+	}
+	// 공유받은 숲에 붙인 태그는 소유자의 태그로 저장됨
+	if _, err := svc.AddTags(editor, &forest.TagsRequest{ForestId: created.Id, Tags: []string{"go"}}); err != nil {
+		t.Fatalf("editor should tag forest: %v", err)
+	}
+
+	got, err := svc.GetForest(ctx, &forest.GetForestRequest{ForestId: created.Id, IncludeChildren: true})
+	if err != nil {
+		t.Fatalf("unexpected error getting forest: %v", err)
+	}
+	if !slices.Equal(got.Forest.Tags, []string{"go"}) || !slices.Equal(got.Forest.Root.Children[0].Tags, []string{"db", "go"}) {
+		t.Fatalf("unexpected tags on forest: %+v", got.Forest)
+	}
+
+	list, err := svc.ListTags(ctx, &forest.ListTagsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error listing tags: %v", err)
+	}
+	if len(list.Tags) != 2 || list.Tags[0].Name != "db" || list.Tags[0].TreeCount != 1 || list.Tags[0].ForestCount != 0 ||
+		list.Tags[1].Name != "go" || list.Tags[1].TreeCount != 1 || list.Tags[1].ForestCount != 1 {
+		t.Fatalf("unexpected tag counts: %+v", list.Tags)
+	}
+	editorTags, err := svc.ListTags(editor, &forest.ListTagsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error listing tags: %v", err)
+	}
+	if len(editorTags.Tags) != 0 {
+		t.Fatalf("expected editor to have no tags, got %+v", editorTags.Tags)
+	}
+
+	found, err := svc.FindTreesByTag(ctx, &forest.FindTreesByTagRequest{Tag: "db"})
+	if err != nil {
+		t.Fatalf("unexpected error finding trees: %v", err)
+	}
+	if len(found.Trees) != 1 || found.Trees[0].Tree.Id != child.Tree.Id || found.Trees[0].ForestId != created.Id {
+		t.Fatalf("unexpected tagged trees: %+v", found.Trees)
+	}
+
+	tags, err = svc.RemoveTags(ctx, &forest.TagsRequest{TreeId: child.Tree.Id, Tags: []string{"db"}})
+	if err != nil {
+		t.Fatalf("unexpected error removing tags: %v", err)
+	}
+	if !slices.Equal(tags.Tags, []string{"go"}) {
+		t.Fatalf("expected remaining tags [go], got %v", tags.Tags)
+	}
+
+	// 휴지통에 있는 트리는 검색되지 않음
+	if _, err := svc.DeleteTree(ctx, &forest.DeleteTreeRequest{TreeId: child.Tree.Id}); err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+	found, err = svc.FindTreesByTag(ctx, &forest.FindTreesByTagRequest{Tag: "go"})
+	if err != nil {
+		t.Fatalf("unexpected error finding trees: %v", err)
+	}
+	if len(found.Trees) != 0 {
+		t.Fatalf("expected no trees after delete, got %+v", found.Trees)
+	}
+
+	for name, req := range map[string]*forest.TagsRequest{
+		"no target":   {Tags: []string{"go"}},
+		"two targets": {ForestId: created.Id, TreeId: created.Root.Id, Tags: []string{"go"}},
+		"empty tag":   {ForestId: created.Id, Tags: []string{"  "}},
+		"no tags":     {ForestId: created.Id},
+	} {
+		if _, err := svc.AddTags(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+}
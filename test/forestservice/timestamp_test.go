@@ -0,0 +1,63 @@
+package forestservice_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jdk829355/InForest_back/protos/forest"
+)
+
+func TestTimestamps(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	before := time.Now()
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	if created.CreatedAt == nil || created.CreatedAt.AsTime().Before(before.Truncate(time.Second)) ||
+		!created.UpdatedAt.AsTime().Equal(created.CreatedAt.AsTime()) {
+		t.Fatalf("unexpected forest timestamps: %v, %v", created.CreatedAt, created.UpdatedAt)
+	}
+	if created.Root.CreatedAt == nil || created.Root.UpdatedAt == nil {
+		t.Fatalf("expected root timestamps, got %+v", created.Root)
+	}
+
+	child, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "child", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	if child.Tree.CreatedAt == nil || child.Memo.CreatedAt == nil || child.Memo.UpdatedAt == nil {
+		t.Fatalf("expected tree and memo timestamps, got %+v", child)
+	}
+
+	updated, err := svc.UpdateTree(ctx, &forest.UpdateTreeRequest{TreeId: child.Tree.Id, Name: "renamed"})
+	if err != nil {
+		t.Fatalf("unexpected error updating tree: %v", err)
+	}
+	if !updated.CreatedAt.AsTime().Equal(child.Tree.CreatedAt.AsTime()) || updated.UpdatedAt.AsTime().Before(child.Tree.UpdatedAt.AsTime()) {
+		t.Fatalf("unexpected tree timestamps after update: %v, %v", updated.CreatedAt, updated.UpdatedAt)
+	}
+
+	memo, err := svc.UpdateMemo(ctx, &forest.UpdateMemoRequest{Memo: &forest.Memo{TreeId: child.Tree.Id, Content: "note"}})
+	if err != nil {
+		t.Fatalf("unexpected error updating memo: %v", err)
+	}
+	newMemo := memo.NewMemo
+	if !newMemo.CreatedAt.AsTime().Equal(child.Memo.CreatedAt.AsTime()) || newMemo.UpdatedAt.AsTime().Before(child.Memo.UpdatedAt.AsTime()) {
+		t.Fatalf("unexpected memo timestamps after update: %v, %v", newMemo.CreatedAt, newMemo.UpdatedAt)
+	}
+	// synced_at은 저장된 수정 시각을 그대로 알려 줌
+	if memo.SyncedAt != newMemo.UpdatedAt.AsTime().Format(time.RFC3339) {
+		t.Fatalf("expected synced_at %s, got %s", newMemo.UpdatedAt.AsTime().Format(time.RFC3339), memo.SyncedAt)
+	}
+
+	got, err := svc.GetForest(ctx, &forest.GetForestRequest{ForestId: created.Id, IncludeChildren: true})
+	if err != nil {
+		t.Fatalf("unexpected error getting forest: %v", err)
+	}
+	if got.Forest.UpdatedAt.AsTime().Before(created.UpdatedAt.AsTime()) || got.Forest.Root.Children[0].UpdatedAt == nil {
+		t.Fatalf("unexpected timestamps after reading forest: %+v", got.Forest)
+	}
+}
@@ -0,0 +1,69 @@
+package forestservice_test
+
+import (
+	"testing"
+
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestUpdateWithFieldMask(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Description: "desc", Root: &forest.Tree{Name: "root", Url: "https://example.com"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+
+	// 마스크에 있는 필드는 빈 값으로도 지워짐
+	updated, err := svc.UpdateForest(ctx, &forest.UpdateForestRequest{
+		ForestId:   created.Id,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error updating forest: %v", err)
+	}
+	if updated.Name != "forest" || updated.Description != "" {
+		t.Fatalf("unexpected updated forest: %+v", updated)
+	}
+
+	tree, err := svc.UpdateTree(ctx, &forest.UpdateTreeRequest{
+		TreeId:     created.Root.Id,
+		Name:       "ignored",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"url"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error updating tree: %v", err)
+	}
+	if tree.Name != "root" || tree.Url != "" {
+		t.Fatalf("unexpected updated tree: %+v", tree)
+	}
+
+	// 마스크가 없으면 비어 있지 않은 필드만 업데이트
+	updated, err = svc.UpdateForest(ctx, &forest.UpdateForestRequest{ForestId: created.Id, Name: "renamed"})
+	if err != nil {
+		t.Fatalf("unexpected error updating forest: %v", err)
+	}
+	if updated.Name != "renamed" || updated.Description != "" {
+		t.Fatalf("unexpected updated forest: %+v", updated)
+	}
+
+	_, err = svc.UpdateForest(ctx, &forest.UpdateForestRequest{
+		ForestId:   created.Id,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"user_id"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for unknown path, got %v", err)
+	}
+	_, err = svc.UpdateTree(ctx, &forest.UpdateTreeRequest{Name: "no id"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for missing id, got %v", err)
+	}
+	_, err = svc.UpdateForest(ctx, &forest.UpdateForestRequest{Name: "no id"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for missing forest id, got %v", err)
+	}
+}
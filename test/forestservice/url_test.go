@@ -0,0 +1,83 @@
+package forestservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFindTreesByUrl(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	other := context.WithValue(context.Background(), "user_id", "user-2")
+
+	first, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "b-session", Root: &forest.Tree{Name: "python", Url: "https://python.org/"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	second, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "a-session", Root: &forest.Tree{Name: "search"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	docs, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "docs", ParentId: second.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	nested, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "python again", Url: "https://Python.org?utm_campaign=x", ParentId: docs.Tree.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	trashed, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "trashed", Url: "https://python.org", ParentId: second.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	if _, err := svc.DeleteTree(ctx, &forest.DeleteTreeRequest{TreeId: trashed.Tree.Id}); err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+
+	shared, err := svc.CreateForest(other, &forest.CreateForestRequest{Name: "c-shared", Root: &forest.Tree{Name: "shared python", Url: "https://python.org/#about"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	if _, err := svc.CreateForest(other, &forest.CreateForestRequest{Name: "private", Root: &forest.Tree{Name: "private python", Url: "https://python.org"}}); err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	if _, err := svc.ShareForest(other, &forest.ShareForestRequest{ForestId: shared.Id, UserId: "user-1", Role: forest.ForestRole_FOREST_ROLE_VIEWER}); err != nil {
+		t.Fatalf("unexpected error sharing forest: %v", err)
+	}
+
+	resp, err := svc.FindTreesByUrl(ctx, &forest.FindTreesByUrlRequest{Url: "HTTPS://python.org:443/?fbclid=1"})
+	if err != nil {
+		t.Fatalf("unexpected error finding trees: %v", err)
+	}
+	if resp.CanonicalUrl != "https://python.org/" {
+		t.Fatalf("unexpected canonical url: %q", resp.CanonicalUrl)
+	}
+	// 숲 이름 순으로 반환되며 휴지통과 접근할 수 없는 숲의 트리는 제외됨
+	if len(resp.Matches) != 3 {
+		t.Fatalf("expected 3 matches, got %+v", resp.Matches)
+	}
+	match := resp.Matches[0]
+	if match.Tree.Id != nested.Tree.Id || match.ForestId != second.Id || match.ForestName != "a-session" || match.HasSummary {
+		t.Fatalf("unexpected first match: %+v", match)
+	}
+	var path []string
+	for _, ref := range match.Path {
+		path = append(path, ref.Name)
+	}
+	if len(path) != 3 || path[0] != "search" || path[1] != "docs" || path[2] != "python again" || match.Path[2].Id != nested.Tree.Id {
+		t.Fatalf("unexpected path: %v", path)
+	}
+	if resp.Matches[1].Tree.Id != first.Root.Id || len(resp.Matches[1].Path) != 1 || resp.Matches[2].Tree.Id != shared.Root.Id {
+		t.Fatalf("unexpected matches: %+v", resp.Matches)
+	}
+
+	_, err = svc.FindTreesByUrl(ctx, &forest.FindTreesByUrlRequest{Url: "  "})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package forestservice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecordVisit(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root", Url: "https://example.com"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	treeID := created.Root.Id
+	if created.Root.VisitCount != 0 || created.Root.FirstVisitedAt != nil {
+		t.Fatalf("expected no visits, got %+v", created.Root)
+	}
+
+	visits := []*forest.RecordVisitRequest{
+		{TreeId: treeID, VisitedAt: "2026-03-02T10:00:00Z", DwellMs: 1500},
+		// 늦게 도착한 이전 방문도 시각 기준으로 집계됨
+		{TreeId: treeID, VisitedAt: "2026-03-01T09:00:00Z", DwellMs: 500},
+		{TreeId: treeID, VisitedAt: "2026-03-03T08:30:00Z"},
+	}
+	var tree *forest.Tree
+	for _, visit := range visits {
+		if tree, err = svc.RecordVisit(ctx, visit); err != nil {
+			t.Fatalf("unexpected error recording visit: %v", err)
+		}
+	}
+	if tree.VisitCount != 3 || tree.TotalDwellMs != 2000 ||
+		tree.FirstVisitedAt.AsTime().Format(time.RFC3339) != "2026-03-01T09:00:00Z" || tree.LastVisitedAt.AsTime().Format(time.RFC3339) != "2026-03-03T08:30:00Z" {
+		t.Fatalf("unexpected visit aggregates: %+v", tree)
+	}
+	got, err := svc.GetTree(ctx, &forest.GetTreeRequest{TreeId: treeID})
+	if err != nil {
+		t.Fatalf("unexpected error getting tree: %v", err)
+	}
+	if got.VisitCount != 3 || got.TotalDwellMs != 2000 {
+		t.Fatalf("expected stored aggregates, got %+v", got)
+	}
+
+	// visited_at이 없으면 현재 시각으로 기록
+	if tree, err = svc.RecordVisit(ctx, &forest.RecordVisitRequest{TreeId: treeID, DwellMs: 10}); err != nil {
+		t.Fatalf("unexpected error recording visit: %v", err)
+	}
+	if tree.VisitCount != 4 || !tree.LastVisitedAt.AsTime().After(time.Date(2026, 3, 3, 8, 30, 0, 0, time.UTC)) {
+		t.Fatalf("expected visit at current time, got %+v", tree)
+	}
+
+	_, err = svc.RecordVisit(ctx, &forest.RecordVisitRequest{TreeId: treeID, VisitedAt: "yesterday"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for visited_at, got %v", err)
+	}
+	future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	_, err = svc.RecordVisit(ctx, &forest.RecordVisitRequest{TreeId: treeID, VisitedAt: future})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for future visited_at, got %v", err)
+	}
+	_, err = svc.RecordVisit(ctx, &forest.RecordVisitRequest{TreeId: treeID, DwellMs: -1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for dwell_ms, got %v", err)
+	}
+
+	other := context.WithValue(context.Background(), "user_id", "user-2")
+	_, err = svc.RecordVisit(other, &forest.RecordVisitRequest{TreeId: treeID})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied, got %v", err)
+	}
+}
+
+func TestRecordVisitNormalizesOffsetToUTC(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	tree, err := svc.RecordVisit(ctx, &forest.RecordVisitRequest{TreeId: created.Root.Id, VisitedAt: "2026-03-02T09:00:00+09:00"})
+	if err != nil {
+		t.Fatalf("unexpected error recording visit: %v", err)
+	}
+	expected := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	if !tree.FirstVisitedAt.AsTime().Equal(expected) || !tree.LastVisitedAt.AsTime().Equal(expected) {
+		t.Fatalf("expected UTC visit time, got %+v", tree)
+	}
+}
@@ -0,0 +1,175 @@
+package forestservice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jdk829355/InForest_back/internal/service/events"
+	"github.com/jdk829355/InForest_back/models"
+	"github.com/jdk829355/InForest_back/protos/forest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type eventRecorder struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *forest.ForestEvent
+}
+
+func (r *eventRecorder) Context() context.Context { return r.ctx }
+
+func (r *eventRecorder) Send(event *forest.ForestEvent) error {
+	r.events <- event
+	return nil
+}
+
+// 구독이 등록되었음을 알려 주는 브로커
+type subscribeSignal struct {
+	events.Broker
+	subscribed chan struct{}
+}
+
+func (b *subscribeSignal) Subscribe(ctx context.Context, forestID string) (<-chan *models.ForestEvent, func(), error) {
+	ch, cancel, err := b.Broker.Subscribe(ctx, forestID)
+	close(b.subscribed)
+	return ch, cancel, err
+}
+
+func TestWatchForestReceivesChanges(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	broker := &subscribeSignal{Broker: events.NewMemoryBroker(), subscribed: make(chan struct{})}
+	svc.Events = broker
+
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+
+	watchCtx, stop := context.WithCancel(ctx)
+	recorder := &eventRecorder{ctx: watchCtx, events: make(chan *forest.ForestEvent, 16)}
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.WatchForest(&forest.WatchForestRequest{ForestId: created.Id}, recorder)
+	}()
+	<-broker.subscribed
+
+	child, err := svc.CreateTree(ctx, &forest.CreateTreeRequest{Name: "child", ParentId: created.Root.Id})
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	if _, err := svc.UpdateTree(ctx, &forest.UpdateTreeRequest{TreeId: child.Tree.Id, Name: "renamed"}); err != nil {
+		t.Fatalf("unexpected error updating tree: %v", err)
+	}
+	if _, err := svc.UpdateForest(ctx, &forest.UpdateForestRequest{ForestId: created.Id, Name: "renamed forest"}); err != nil {
+		t.Fatalf("unexpected error updating forest: %v", err)
+	}
+	if _, err := svc.DeleteTree(ctx, &forest.DeleteTreeRequest{TreeId: child.Tree.Id}); err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+
+	expected := []forest.ForestEventType{
+		forest.ForestEventType_FOREST_EVENT_TYPE_TREE_ADDED,
+		forest.ForestEventType_FOREST_EVENT_TYPE_TREE_UPDATED,
+		forest.ForestEventType_FOREST_EVENT_TYPE_FOREST_UPDATED,
+		forest.ForestEventType_FOREST_EVENT_TYPE_TREE_DELETED,
+	}
+	for i, want := range expected {
+		select {
+		case event := <-recorder.events:
+			if event.Type != want || event.ForestId != created.Id || event.UserId != "user-1" || event.OccurredAt == nil {
+				t.Fatalf("event %d: expected %v, got %+v", i, want, event)
+			}
+			if want == forest.ForestEventType_FOREST_EVENT_TYPE_TREE_ADDED && (event.Tree.GetName() != "child" || event.ParentId != created.Root.Id) {
+				t.Fatalf("unexpected tree added event: %+v", event)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for event %d (%v)", i, want)
+		}
+	}
+
+	stop()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("unexpected error from WatchForest: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("WatchForest did not return after cancel")
+	}
+}
+
+func TestWatchForestEndsWhenAccessRevoked(t *testing.T) {
+	t.Parallel()
+
+	svc, ctx := newService(t)
+	broker := &subscribeSignal{Broker: events.NewMemoryBroker(), subscribed: make(chan struct{})}
+	svc.Events = broker
+
+	created, err := svc.CreateForest(ctx, &forest.CreateForestRequest{Name: "forest", Root: &forest.Tree{Name: "root"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	if _, err := svc.ShareForest(ctx, &forest.ShareForestRequest{ForestId: created.Id, UserId: "user-2", Role: forest.ForestRole_FOREST_ROLE_VIEWER}); err != nil {
+		t.Fatalf("unexpected error sharing forest: %v", err)
+	}
+
+	viewer := context.WithValue(context.Background(), "user_id", "user-2")
+	recorder := &eventRecorder{ctx: viewer, events: make(chan *forest.ForestEvent, 16)}
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.WatchForest(&forest.WatchForestRequest{ForestId: created.Id}, recorder)
+	}()
+	<-broker.subscribed
+
+	if _, err := svc.UnshareForest(ctx, &forest.UnshareForestRequest{ForestId: created.Id, UserId: "user-2"}); err != nil {
+		t.Fatalf("unexpected error unsharing forest: %v", err)
+	}
+	select {
+	case err := <-done:
+		if status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("expected PermissionDenied after unshare, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("WatchForest did not end after access was revoked")
+	}
+	select {
+	case event := <-recorder.events:
+		t.Fatalf("expected no event after access was revoked, got %+v", event)
+	default:
+	}
+}
+
+func TestMemoryBrokerIsolatesForests(t *testing.T) {
+	t.Parallel()
+
+	broker := events.NewMemoryBroker()
+	ctx, cancel := context.WithCancel(context.Background())
+	a, _, err := broker.Subscribe(ctx, "forest-a")
+	if err != nil {
+		t.Fatalf("unexpected error subscribing: %v", err)
+	}
+	if err := broker.Publish(ctx, &models.ForestEvent{Type: models.ForestEventTreeAdded, ForestId: "forest-b"}); err != nil {
+		t.Fatalf("unexpected error publishing: %v", err)
+	}
+	if err := broker.Publish(ctx, &models.ForestEvent{Type: models.ForestEventTreeDeleted, ForestId: "forest-a"}); err != nil {
+		t.Fatalf("unexpected error publishing: %v", err)
+	}
+	if event := <-a; event.Type != models.ForestEventTreeDeleted {
+		t.Fatalf("expected only forest-a events, got %+v", event)
+	}
+
+	cancel()
+	select {
+	case _, ok := <-a:
+		if ok {
+			t.Fatal("expected no more events")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("subscription channel was not closed after cancel")
+	}
+}
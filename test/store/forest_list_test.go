@@ -0,0 +1,74 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jdk829355/InForest_back/internal/store"
+	"github.com/jdk829355/InForest_back/models"
+)
+
+func TestForestRepositoryListPagination(t *testing.T) {
+	t.Parallel()
+
+	forEachForestRepository(t, testListPagination)
+}
+
+// testListPagination은 커서로 끝까지 넘긴 목록이 정렬 순서를 지키고, 정렬 조건과 맞지 않는 커서를 거부하는지 확인합니다.
+func testListPagination(t *testing.T, repo store.ForestRepository, userID string) {
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		root := &models.Tree{Name: "root"}
+		if err := repo.CreateForest(ctx, &models.Forest{Name: fmt.Sprintf("forest-%d", i), UserId: userID}, root); err != nil {
+			t.Fatalf("unexpected error creating forest: %v", err)
+		}
+		if i%2 == 0 {
+			createTree(t, repo, "child", root.Id)
+		}
+	}
+	newForest(t, repo, userID+"-other")
+
+	// 이름 역순으로 2개씩 끝까지 조회
+	opts := store.ForestListOptions{PageSize: 2, SortBy: store.ForestSortName, Descending: true}
+	var names []string
+	for page := 0; ; page++ {
+		forests, next, err := repo.GetForestByUser(ctx, userID, opts)
+		if err != nil {
+			t.Fatalf("unexpected error listing forests: %v", err)
+		}
+		for _, f := range forests {
+			names = append(names, f.Name)
+		}
+		if next == "" {
+			break
+		}
+		if page > 5 {
+			t.Fatalf("pagination did not terminate")
+		}
+		opts.PageToken = next
+	}
+	expected := []string{"forest-4", "forest-3", "forest-2", "forest-1", "forest-0"}
+	if fmt.Sprint(names) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v, got %v", expected, names)
+	}
+
+	// 정렬 조건이 바뀌면 이전 토큰은 사용할 수 없음
+	opts.Descending = false
+	if _, _, err := repo.GetForestByUser(ctx, userID, opts); !errors.Is(err, store.ErrInvalidPageToken) {
+		t.Fatalf("expected ErrInvalidPageToken, got %v", err)
+	}
+
+	forests, next, err := repo.GetForestByUser(ctx, userID, store.ForestListOptions{
+		SortBy:     store.ForestSortCreatedAt,
+		NamePrefix: "forest-",
+		MinTrees:   2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error listing forests: %v", err)
+	}
+	if next != "" || len(forests) != 3 || forests[0].Name != "forest-0" || forests[2].Name != "forest-4" {
+		t.Fatalf("unexpected filtered forests: %+v", forests)
+	}
+}
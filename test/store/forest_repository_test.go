@@ -0,0 +1,426 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jdk829355/InForest_back/internal/service/urlcanon"
+	"github.com/jdk829355/InForest_back/internal/store"
+	"github.com/jdk829355/InForest_back/models"
+)
+
+func newForest(t *testing.T, repo store.ForestRepository, userID string) (*models.Forest, *models.Tree) {
+	t.Helper()
+
+	root := &models.Tree{Name: "root", Url: "https://python.org"}
+	forest := &models.Forest{Name: "forest", UserId: userID, Root: root}
+	if err := repo.CreateForest(context.Background(), forest, root); err != nil {
+		t.Fatalf("unexpected error creating forest: %v", err)
+	}
+	return forest, root
+}
+
+func createTree(t *testing.T, repo store.ForestRepository, name string, parentID string) string {
+	t.Helper()
+
+	id, err := repo.CreateTree(context.Background(), &models.Tree{Name: name}, parentID, -1)
+	if err != nil {
+		t.Fatalf("unexpected error creating tree %q: %v", name, err)
+	}
+	return id
+}
+
+func assertCounters(t *testing.T, repo store.ForestRepository, forestID string, depth int32, total int32) {
+	t.Helper()
+
+	forest, err := repo.GetForest(context.Background(), forestID, false, 0)
+	if err != nil {
+		t.Fatalf("unexpected error getting forest: %v", err)
+	}
+	if forest.Depth != depth || forest.TotalTrees != total {
+		t.Fatalf("expected depth=%d total_trees=%d, got depth=%d total_trees=%d", depth, total, forest.Depth, forest.TotalTrees)
+	}
+}
+
+func TestForestRepositoryCounters(t *testing.T) {
+	t.Parallel()
+
+	forEachForestRepository(t, testCounters)
+}
+
+// testCounters는 트리를 만들고 지울 때마다 숲의 depth/total_trees가 다시 계산되고,
+// 실패한 변경은 숲 정보와 트리 구조를 바꾸지 않는지 확인합니다.
+func testCounters(t *testing.T, repo store.ForestRepository, userID string) {
+	forest, root := newForest(t, repo, userID)
+	assertCounters(t, repo, forest.Id, 1, 1)
+
+	child := createTree(t, repo, "child", root.Id)
+	grandchild := createTree(t, repo, "grandchild", child)
+	sibling := createTree(t, repo, "sibling", root.Id)
+	assertCounters(t, repo, forest.Id, 3, 4)
+
+	// 실패한 변경은 아무것도 반영하지 않음
+	if _, err := repo.DeleteTree(context.Background(), root.Id, false); !errors.Is(err, store.ErrRootHasMultipleChildren) {
+		t.Fatalf("expected ErrRootHasMultipleChildren, got %v", err)
+	}
+	if _, err := repo.MoveTree(context.Background(), child, grandchild); !errors.Is(err, store.ErrMoveIntoDescendant) {
+		t.Fatalf("expected ErrMoveIntoDescendant, got %v", err)
+	}
+	assertCounters(t, repo, forest.Id, 3, 4)
+	assertChildren(t, repo, root.Id, child, sibling)
+	assertChildren(t, repo, child, grandchild)
+
+	// 중간 트리를 cascade 없이 삭제하면 손자가 루트에 연결됨
+	deleted, err := repo.DeleteTree(context.Background(), child, false)
+	if err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+	if len(deleted) != 1 || deleted[0] != child {
+		t.Fatalf("expected only %q to be deleted, got %v", child, deleted)
+	}
+	assertCounters(t, repo, forest.Id, 2, 3)
+	// 손자가 삭제된 트리의 자리를 이어받음
+	assertChildren(t, repo, root.Id, grandchild, sibling)
+
+	// 리프 트리 삭제
+	if _, err := repo.DeleteTree(context.Background(), grandchild, true); err != nil {
+		t.Fatalf("unexpected error deleting leaf tree: %v", err)
+	}
+	assertCounters(t, repo, forest.Id, 2, 2)
+}
+
+func TestForestRepositoryCascadeDelete(t *testing.T) {
+	t.Parallel()
+
+	forEachForestRepository(t, func(t *testing.T, repo store.ForestRepository, userID string) {
+		forest, root := newForest(t, repo, userID)
+		child := createTree(t, repo, "child", root.Id)
+		createTree(t, repo, "grandchild", child)
+
+		deleted, err := repo.DeleteTree(context.Background(), child, true)
+		if err != nil {
+			t.Fatalf("unexpected error deleting tree: %v", err)
+		}
+		if len(deleted) != 2 {
+			t.Fatalf("expected 2 deleted trees, got %v", deleted)
+		}
+		assertCounters(t, repo, forest.Id, 1, 1)
+
+		if _, err := repo.GetTreeByID(context.Background(), child, false, 0); !errors.Is(err, store.ErrTreeNotFound) {
+			t.Fatalf("expected ErrTreeNotFound, got %v", err)
+		}
+	})
+}
+
+func TestForestRepositoryForestLifecycle(t *testing.T) {
+	t.Parallel()
+
+	forEachForestRepository(t, func(t *testing.T, repo store.ForestRepository, userID string) {
+		forest, root := newForest(t, repo, userID)
+		newForest(t, repo, userID+"-other")
+		createTree(t, repo, "child", root.Id)
+
+		forests, _, err := repo.GetForestByUser(context.Background(), userID, store.ForestListOptions{IncludeChildren: true})
+		if err != nil {
+			t.Fatalf("unexpected error listing forests: %v", err)
+		}
+		if len(forests) != 1 || len(forests[0].Root.Children) != 1 {
+			t.Fatalf("expected one forest with one child, got %+v", forests)
+		}
+
+		updated, err := repo.UpdateForest(context.Background(), &models.Forest{Id: forest.Id, Description: "desc"}, []string{"description"})
+		if err != nil {
+			t.Fatalf("unexpected error updating forest: %v", err)
+		}
+		if updated.Name != "forest" || updated.Description != "desc" {
+			t.Fatalf("unexpected forest after update: %+v", updated)
+		}
+
+		ids, err := repo.DeleteForest(context.Background(), forest.Id)
+		if err != nil {
+			t.Fatalf("unexpected error deleting forest: %v", err)
+		}
+		if len(ids) != 2 {
+			t.Fatalf("expected 2 tree ids, got %v", ids)
+		}
+		if _, err := repo.GetForest(context.Background(), forest.Id, false, 0); !errors.Is(err, store.ErrForestNotFound) {
+			t.Fatalf("expected ErrForestNotFound, got %v", err)
+		}
+	})
+}
+
+func TestForestRepositoryMoveTree(t *testing.T) {
+	t.Parallel()
+
+	forEachForestRepository(t, func(t *testing.T, repo store.ForestRepository, userID string) {
+		src, srcRoot := newForest(t, repo, userID)
+		dst, dstRoot := newForest(t, repo, userID)
+		child := createTree(t, repo, "child", srcRoot.Id)
+		grandchild := createTree(t, repo, "grandchild", child)
+		assertCounters(t, repo, src.Id, 3, 3)
+
+		if _, err := repo.MoveTree(context.Background(), child, grandchild); !errors.Is(err, store.ErrMoveIntoDescendant) {
+			t.Fatalf("expected ErrMoveIntoDescendant, got %v", err)
+		}
+		if _, err := repo.MoveTree(context.Background(), srcRoot.Id, dstRoot.Id); !errors.Is(err, store.ErrMoveRootTree) {
+			t.Fatalf("expected ErrMoveRootTree, got %v", err)
+		}
+
+		// 다른 숲으로 이동하면 두 숲의 정보가 모두 갱신됨
+		if _, err := repo.MoveTree(context.Background(), child, dstRoot.Id); err != nil {
+			t.Fatalf("unexpected error moving tree: %v", err)
+		}
+		assertCounters(t, repo, src.Id, 1, 1)
+		assertCounters(t, repo, dst.Id, 3, 3)
+
+		// 이동한 하위 트리에 새 트리를 만들면 이동한 숲의 정보가 갱신됨
+		createTree(t, repo, "great-grandchild", grandchild)
+		assertCounters(t, repo, dst.Id, 4, 4)
+	})
+}
+
+func TestForestRepositorySplitAndGraft(t *testing.T) {
+	t.Parallel()
+
+	forEachForestRepository(t, func(t *testing.T, repo store.ForestRepository, userID string) {
+		src, srcRoot := newForest(t, repo, userID)
+		child := createTree(t, repo, "child", srcRoot.Id)
+		createTree(t, repo, "grandchild", child)
+
+		split, err := repo.SplitForest(context.Background(), child, &models.Forest{Name: "split"})
+		if err != nil {
+			t.Fatalf("unexpected error splitting forest: %v", err)
+		}
+		if split.UserId != userID || split.Root.Id != child || split.CreatedAt.IsZero() || split.UpdatedAt.Before(split.CreatedAt) {
+			t.Fatalf("unexpected split forest: %+v", split)
+		}
+		if _, offset := split.CreatedAt.Zone(); offset != 0 {
+			t.Fatalf("expected UTC timestamps, got created_at=%v", split.CreatedAt)
+		}
+		if _, offset := split.UpdatedAt.Zone(); offset != 0 {
+			t.Fatalf("expected UTC timestamps, got updated_at=%v", split.UpdatedAt)
+		}
+		assertCounters(t, repo, src.Id, 1, 1)
+		assertCounters(t, repo, split.Id, 2, 2)
+
+		grafted, err := repo.GraftForest(context.Background(), split.Id, srcRoot.Id)
+		if err != nil {
+			t.Fatalf("unexpected error grafting forest: %v", err)
+		}
+		if grafted.Id != src.Id || grafted.Depth != 3 || grafted.TotalTrees != 3 {
+			t.Fatalf("unexpected forest after graft: %+v", grafted)
+		}
+		if _, err := repo.GetForest(context.Background(), split.Id, false, 0); !errors.Is(err, store.ErrForestNotFound) {
+			t.Fatalf("expected grafted forest to be removed, got %v", err)
+		}
+
+		other, _ := newForest(t, repo, userID+"-other")
+		if _, err := repo.GraftForest(context.Background(), other.Id, srcRoot.Id); !errors.Is(err, store.ErrForestOwnerMismatch) {
+			t.Fatalf("expected ErrForestOwnerMismatch, got %v", err)
+		}
+	})
+}
+
+func TestForestRepositoryChildOrder(t *testing.T) {
+	t.Parallel()
+
+	forEachForestRepository(t, testChildOrder)
+}
+
+// testChildOrder는 자식들이 이름이나 생성 순서와 관계없이 저장된 위치 순서대로 반환되는지 확인합니다.
+func testChildOrder(t *testing.T, repo store.ForestRepository, userID string) {
+	ctx := context.Background()
+	_, root := newForest(t, repo, userID)
+	b := createTree(t, repo, "b", root.Id)
+	c := createTree(t, repo, "c", root.Id)
+	// 이름과 관계없이 요청한 위치에 추가됨
+	a, err := repo.CreateTree(ctx, &models.Tree{Name: "z"}, root.Id, 0)
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	assertChildren(t, repo, root.Id, a, b, c)
+
+	if _, err := repo.ReorderChildren(ctx, root.Id, []string{c, a, b}); err != nil {
+		t.Fatalf("unexpected error reordering children: %v", err)
+	}
+	assertChildren(t, repo, root.Id, c, a, b)
+
+	for _, ids := range [][]string{{c, a}, {c, a, a}, {c, a, b, root.Id}} {
+		if _, err := repo.ReorderChildren(ctx, root.Id, ids); !errors.Is(err, store.ErrInvalidChildOrder) {
+			t.Fatalf("expected ErrInvalidChildOrder for %v, got %v", ids, err)
+		}
+	}
+
+	// cascade 없이 삭제하면 자식들이 삭제된 트리의 자리를 이어받음
+	a1 := createTree(t, repo, "a1", a)
+	a2 := createTree(t, repo, "a2", a)
+	if _, err := repo.DeleteTree(ctx, a, false); err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+	assertChildren(t, repo, root.Id, c, a1, a2, b)
+
+	// 순서를 바꾼 뒤 중간에 추가하거나 옮겨 온 자식도 위치를 지킴
+	d, err := repo.CreateTree(ctx, &models.Tree{Name: "d"}, root.Id, 1)
+	if err != nil {
+		t.Fatalf("unexpected error creating tree: %v", err)
+	}
+	assertChildren(t, repo, root.Id, c, d, a1, a2, b)
+	if _, err := repo.MoveTree(ctx, a2, c); err != nil {
+		t.Fatalf("unexpected error moving tree: %v", err)
+	}
+	assertChildren(t, repo, root.Id, c, d, a1, b)
+	assertChildren(t, repo, c, a2)
+	if _, err := repo.ReorderChildren(ctx, root.Id, []string{b, a1, d, c}); err != nil {
+		t.Fatalf("unexpected error reordering children: %v", err)
+	}
+	assertChildren(t, repo, root.Id, b, a1, d, c)
+}
+
+func assertChildren(t *testing.T, repo store.ForestRepository, parentID string, expected ...string) {
+	t.Helper()
+
+	tree, err := repo.GetTreeByID(context.Background(), parentID, true, 0)
+	if err != nil {
+		t.Fatalf("unexpected error getting tree: %v", err)
+	}
+	var actual []string
+	for _, child := range tree.Children {
+		actual = append(actual, child.Id)
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected children %v, got %v", expected, actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("expected children %v, got %v", expected, actual)
+		}
+	}
+}
+
+func TestForestRepositorySubtreeLoading(t *testing.T) {
+	t.Parallel()
+
+	forEachForestRepository(t, testSubtreeLoading)
+}
+
+// testSubtreeLoading은 한 번에 불러온 하위 트리가 형제 순서와 깊이 제한을 지키고,
+// 휴지통에 있는 트리와 그 아래 트리를 빼는지 확인합니다.
+func testSubtreeLoading(t *testing.T, repo store.ForestRepository, userID string) {
+	ctx := context.Background()
+	forest, root := newForest(t, repo, userID)
+	a := createTree(t, repo, "a", root.Id)
+	b := createTree(t, repo, "b", root.Id)
+	c := createTree(t, repo, "c", root.Id)
+	a1 := createTree(t, repo, "a1", a)
+	a11 := createTree(t, repo, "a1-1", a1)
+	a2 := createTree(t, repo, "a2", a)
+	b1 := createTree(t, repo, "b1", b)
+	createTree(t, repo, "b1-1", b1)
+	if _, err := repo.DeleteTree(ctx, b1, true); err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+
+	// 깊이 제한 없이 숲 전체를 불러옴
+	got, err := repo.GetForest(ctx, forest.Id, true, 0)
+	if err != nil {
+		t.Fatalf("unexpected error getting forest: %v", err)
+	}
+	if got.Root.Id != root.Id {
+		t.Fatalf("expected root %q, got %+v", root.Id, got.Root)
+	}
+	expected := map[string][]string{root.Id: {a, b, c}, a: {a1, a2}, a1: {a11}, a11: nil, a2: nil, b: nil, c: nil}
+	var walk func(tree *models.Tree)
+	walk = func(tree *models.Tree) {
+		want, ok := expected[tree.Id]
+		if !ok {
+			t.Fatalf("unexpected tree %q in loaded forest", tree.Id)
+		}
+		delete(expected, tree.Id)
+		if len(tree.Children) != len(want) {
+			t.Fatalf("expected children %v of %q, got %+v", want, tree.Name, tree.Children)
+		}
+		for i, child := range tree.Children {
+			if child.Id != want[i] {
+				t.Fatalf("expected children %v of %q, got %+v", want, tree.Name, tree.Children)
+			}
+			walk(child)
+		}
+	}
+	walk(got.Root)
+	if len(expected) != 0 {
+		t.Fatalf("trees missing from loaded forest: %v", expected)
+	}
+
+	got, err = repo.GetForest(ctx, forest.Id, true, 2)
+	if err != nil {
+		t.Fatalf("unexpected error getting forest: %v", err)
+	}
+	if len(got.Root.Children) != 3 || len(got.Root.Children[0].Children) != 2 || len(got.Root.Children[0].Children[0].Children) != 0 {
+		t.Fatalf("expected two levels below the root, got %+v", got.Root)
+	}
+
+	// 중간 트리부터 불러와도 같은 구조를 반환함
+	subtree, err := repo.GetTreeByID(ctx, a, true, 1)
+	if err != nil {
+		t.Fatalf("unexpected error getting tree: %v", err)
+	}
+	if len(subtree.Children) != 2 || subtree.Children[0].Id != a1 || len(subtree.Children[0].Children) != 0 {
+		t.Fatalf("expected one level below %q, got %+v", a, subtree)
+	}
+
+	children, next, err := repo.ListChildren(ctx, root.Id, 2, "")
+	if err != nil {
+		t.Fatalf("unexpected error listing children: %v", err)
+	}
+	if len(children) != 2 || children[0].Id != a || children[0].ChildCount != 2 || children[1].ChildCount != 0 || next == "" {
+		t.Fatalf("unexpected first page: %+v (next=%q)", children, next)
+	}
+	children, next, err = repo.ListChildren(ctx, root.Id, 2, next)
+	if err != nil {
+		t.Fatalf("unexpected error listing children: %v", err)
+	}
+	if len(children) != 1 || children[0].Id != c || next != "" {
+		t.Fatalf("unexpected last page: %+v (next=%q)", children, next)
+	}
+
+	if _, _, err := repo.ListChildren(ctx, root.Id, 2, "not a token"); !errors.Is(err, store.ErrInvalidPageToken) {
+		t.Fatalf("expected ErrInvalidPageToken, got %v", err)
+	}
+}
+
+func TestMemoryForestStoreBackfillCanonicalUrls(t *testing.T) {
+	t.Parallel()
+
+	repo := store.NewMemoryForestStore()
+	ctx := context.Background()
+	// canonical_url 없이 저장된 이전 트리
+	_, root := newForest(t, repo, "user-1")
+	canonicalize := urlcanon.New(urlcanon.DefaultTrackingParams).Canonicalize
+
+	matches, err := repo.FindTreesByUrl(ctx, "user-1", "https://python.org/")
+	if err != nil {
+		t.Fatalf("unexpected error finding trees: %v", err)
+	}
+	if len(matches) != 0 {
+		t.Fatalf("expected no match before backfill, got %+v", matches)
+	}
+
+	filled, err := repo.BackfillCanonicalUrls(ctx, canonicalize)
+	if err != nil {
+		t.Fatalf("unexpected error backfilling: %v", err)
+	}
+	if filled != 1 {
+		t.Fatalf("expected 1 backfilled tree, got %d", filled)
+	}
+	matches, err = repo.FindTreesByUrl(ctx, "user-1", "https://python.org/")
+	if err != nil {
+		t.Fatalf("unexpected error finding trees: %v", err)
+	}
+	if len(matches) != 1 || matches[0].Tree.Id != root.Id {
+		t.Fatalf("expected backfilled root to match, got %+v", matches)
+	}
+	if filled, err = repo.BackfillCanonicalUrls(ctx, canonicalize); err != nil || filled != 0 {
+		t.Fatalf("expected second backfill to be a no-op, got %d, %v", filled, err)
+	}
+}
@@ -0,0 +1,77 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jdk829355/InForest_back/internal/store"
+	"github.com/jdk829355/InForest_back/models"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// testGraftRemovesShareLinksAndMembers는 숲을 붙인 뒤 원래 숲의 공개 링크와 멤버가 남지 않는지 확인하고
+// 원래 숲의 링크 ID를 반환합니다.
+func testGraftRemovesShareLinksAndMembers(t *testing.T, repo store.ForestRepository, userID string) string {
+	t.Helper()
+
+	ctx := context.Background()
+	dst, dstRoot := newForest(t, repo, userID)
+	src, _ := newForest(t, repo, userID)
+	link, err := repo.CreateShareLink(ctx, src.Id, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error creating share link: %v", err)
+	}
+	if _, err := repo.ShareForest(ctx, src.Id, userID+"-viewer", models.ForestRoleViewer); err != nil {
+		t.Fatalf("unexpected error sharing forest: %v", err)
+	}
+
+	if _, err := repo.GraftForest(ctx, src.Id, dstRoot.Id); err != nil {
+		t.Fatalf("unexpected error grafting forest: %v", err)
+	}
+	if _, err := repo.GetShareLink(ctx, link.Token); !errors.Is(err, store.ErrShareLinkNotFound) {
+		t.Fatalf("expected ErrShareLinkNotFound after graft, got %v", err)
+	}
+	links, err := repo.ListShareLinks(ctx, src.Id)
+	if err != nil {
+		t.Fatalf("unexpected error listing share links: %v", err)
+	}
+	if len(links) != 0 {
+		t.Fatalf("expected no share links for grafted forest, got %d", len(links))
+	}
+	if role, err := repo.GetMemberRole(ctx, src.Id, userID+"-viewer"); !errors.Is(err, store.ErrForestNotFound) && role != "" {
+		t.Fatalf("expected membership to be removed after graft, got role %q (%v)", role, err)
+	}
+	members, err := repo.ListForestMembers(ctx, dst.Id)
+	if err != nil {
+		t.Fatalf("unexpected error listing members: %v", err)
+	}
+	if len(members) != 0 {
+		t.Fatalf("expected grafted forest members not to move to the target forest, got %+v", members)
+	}
+	return link.Id
+}
+
+func TestMemoryForestStoreGraftRemovesShareLinks(t *testing.T) {
+	t.Parallel()
+
+	testGraftRemovesShareLinksAndMembers(t, store.NewMemoryForestStore(), "user-1")
+}
+
+// NEO4J_TEST_URI가 설정된 경우에만 실제 Neo4j에 대해 실행됨
+func TestNeo4jStoreGraftRemovesShareLinks(t *testing.T) {
+	ctx := context.Background()
+	repo, driver := newNeo4jRepository(t)
+
+	linkID := testGraftRemovesShareLinksAndMembers(t, repo, "graft-test-"+time.Now().Format("20060102150405.000000000"))
+	// 숲에 연결되지 않은 링크 노드는 저장소 메서드로는 보이지 않으므로 직접 확인
+	result, err := neo4j.ExecuteQuery(ctx, driver, `MATCH (l:ShareLink {id: $id}) RETURN count(l) AS links`,
+		map[string]any{"id": linkID}, neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase("neo4j"))
+	if err != nil {
+		t.Fatalf("unexpected error counting share links: %v", err)
+	}
+	if links, _, _ := neo4j.GetRecordValue[int64](result.Records[0], "links"); links != 0 {
+		t.Fatalf("expected share link node to be deleted with the grafted forest, got %d", links)
+	}
+}
@@ -0,0 +1,98 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/jdk829355/InForest_back/internal/store"
+)
+
+func TestMemoryMemoStoreCompareAndSwap(t *testing.T) {
+	t.Parallel()
+
+	memos := store.NewMemoryMemoStore()
+	ctx := context.Background()
+	if _, err := memos.CreateMemo(ctx, "user-1", "tree-1", nil); err != nil {
+		t.Fatalf("unexpected error creating memo: %v", err)
+	}
+
+	// 같은 버전으로 동시에 업데이트하면 하나만 성공해야 함
+	const writers = 8
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+	)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := memos.UpdateMemo(ctx, "user-1", "tree-1", "content", 0)
+			if err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			} else if !errors.Is(err, store.ErrMemoVersionConflict) {
+				t.Errorf("expected ErrMemoVersionConflict, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if succeeded != 1 {
+		t.Fatalf("expected exactly one successful update, got %d", succeeded)
+	}
+	memo, err := memos.GetMemo(ctx, "user-1", "tree-1")
+	if err != nil {
+		t.Fatalf("unexpected error getting memo: %v", err)
+	}
+	if memo.Version != 1 || memo.Content != "content" {
+		t.Fatalf("unexpected memo after update: %+v", memo)
+	}
+}
+
+func TestMemoryMemoStoreNotFound(t *testing.T) {
+	t.Parallel()
+
+	memos := store.NewMemoryMemoStore()
+	ctx := context.Background()
+	if _, err := memos.GetMemo(ctx, "user-1", "missing"); !errors.Is(err, store.ErrMemoNotFound) {
+		t.Fatalf("expected ErrMemoNotFound, got %v", err)
+	}
+	if _, err := memos.UpdateMemo(ctx, "user-1", "missing", "content", 0); !errors.Is(err, store.ErrMemoNotFound) {
+		t.Fatalf("expected ErrMemoNotFound, got %v", err)
+	}
+	if _, err := memos.DeleteMemo(ctx, "user-1", "missing"); !errors.Is(err, store.ErrMemoNotFound) {
+		t.Fatalf("expected ErrMemoNotFound, got %v", err)
+	}
+}
+
+func TestMemoryMemoStoreGetMemos(t *testing.T) {
+	t.Parallel()
+
+	memos := store.NewMemoryMemoStore()
+	ctx := context.Background()
+	for _, treeID := range []string{"tree-1", "tree-2"} {
+		if _, err := memos.CreateMemo(ctx, "user-1", treeID, map[string]interface{}{"content": treeID + " note"}); err != nil {
+			t.Fatalf("unexpected error creating memo: %v", err)
+		}
+	}
+	if _, err := memos.CreateMemo(ctx, "user-2", "tree-3", nil); err != nil {
+		t.Fatalf("unexpected error creating memo: %v", err)
+	}
+
+	// 메모가 없는 트리와 다른 사용자의 메모는 빠져야 함
+	got, err := memos.GetMemos(ctx, "user-1", []string{"tree-1", "missing", "tree-2", "tree-3"})
+	if err != nil {
+		t.Fatalf("unexpected error getting memos: %v", err)
+	}
+	contents := map[string]string{}
+	for _, memo := range got {
+		contents[memo.TreeID] = memo.Content
+	}
+	if len(contents) != 2 || contents["tree-1"] != "tree-1 note" || contents["tree-2"] != "tree-2 note" {
+		t.Fatalf("unexpected memos: %+v", contents)
+	}
+}
@@ -0,0 +1,48 @@
+package store_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jdk829355/InForest_back/internal/store"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// forEachForestRepository는 test를 메모리 저장소와 Neo4j 저장소에 대해 하위 테스트로 실행합니다.
+// Neo4j 저장소는 NEO4J_TEST_URI가 설정된 경우에만 실행되며, 실행마다 같은 데이터베이스를 쓰므로
+// 사용자별로 조회하는 테스트가 서로 섞이지 않도록 실행마다 다른 사용자 ID를 넘깁니다.
+func forEachForestRepository(t *testing.T, test func(t *testing.T, repo store.ForestRepository, userID string)) {
+	t.Run("memory", func(t *testing.T) {
+		t.Parallel()
+		test(t, store.NewMemoryForestStore(), "user-1")
+	})
+	t.Run("neo4j", func(t *testing.T) {
+		t.Parallel()
+		repo, _ := newNeo4jRepository(t)
+		test(t, repo, strings.ReplaceAll(t.Name(), "/", "-")+"-"+time.Now().Format("20060102150405.000000000"))
+	})
+}
+
+// newNeo4jRepository는 NEO4J_TEST_URI의 Neo4j에 연결된 저장소와 드라이버를 반환합니다.
+// NEO4J_TEST_URI가 설정되지 않았으면 테스트를 건너뜁니다.
+func newNeo4jRepository(t *testing.T) (*store.Neo4jStore, neo4j.DriverWithContext) {
+	t.Helper()
+
+	uri := os.Getenv("NEO4J_TEST_URI")
+	if uri == "" {
+		t.Skip("NEO4J_TEST_URI is not set")
+	}
+	driver, err := neo4j.NewDriverWithContext(uri, neo4j.BasicAuth(os.Getenv("NEO4J_TEST_USERNAME"), os.Getenv("NEO4J_TEST_PASSWORD"), ""))
+	if err != nil {
+		t.Fatalf("unexpected error creating driver: %v", err)
+	}
+	t.Cleanup(func() { driver.Close(context.Background()) })
+	repo, err := store.NewNeo4jStore(driver)
+	if err != nil {
+		t.Fatalf("unexpected error creating store: %v", err)
+	}
+	return repo, driver
+}
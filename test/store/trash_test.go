@@ -0,0 +1,219 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jdk829355/InForest_back/internal/store"
+	"github.com/jdk829355/InForest_back/models"
+)
+
+func TestForestRepositoryTrashAndRestore(t *testing.T) {
+	t.Parallel()
+
+	forEachForestRepository(t, testTrashAndRestore)
+}
+
+func testTrashAndRestore(t *testing.T, repo store.ForestRepository, userID string) {
+	ctx := context.Background()
+	forest, root := newForest(t, repo, userID)
+	child := createTree(t, repo, "child", root.Id)
+	createTree(t, repo, "grandchild", child)
+
+	if _, err := repo.DeleteTree(ctx, child, true); err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+	assertCounters(t, repo, forest.Id, 1, 1)
+	if _, err := repo.GetTreeByID(ctx, child, false, 0); !errors.Is(err, store.ErrTreeNotFound) {
+		t.Fatalf("expected ErrTreeNotFound for trashed tree, got %v", err)
+	}
+
+	// 하위 트리는 부모와 함께 복원되므로 휴지통 목록에는 최상위 항목만 나옴
+	items, err := repo.ListTrash(ctx, userID)
+	if err != nil {
+		t.Fatalf("unexpected error listing trash: %v", err)
+	}
+	if len(items) != 1 || items[0].Id != child || items[0].Type != models.TrashItemTree || items[0].ForestId != forest.Id {
+		t.Fatalf("unexpected trash items: %+v", items)
+	}
+	if items[0].DeletedAt.IsZero() {
+		t.Fatalf("expected deleted_at on trash item, got %+v", items[0])
+	}
+	if items, _ := repo.ListTrash(ctx, userID+"-other"); len(items) != 0 {
+		t.Fatalf("expected empty trash for another user, got %+v", items)
+	}
+
+	if _, err := repo.RestoreTree(ctx, child); err != nil {
+		t.Fatalf("unexpected error restoring tree: %v", err)
+	}
+	assertCounters(t, repo, forest.Id, 3, 3)
+	if _, err := repo.RestoreTree(ctx, child); !errors.Is(err, store.ErrNotInTrash) {
+		t.Fatalf("expected ErrNotInTrash, got %v", err)
+	}
+
+	if _, err := repo.DeleteForest(ctx, forest.Id); err != nil {
+		t.Fatalf("unexpected error deleting forest: %v", err)
+	}
+	// 숲이 휴지통에 있으면 그 안의 트리에도 접근할 수 없음
+	if _, err := repo.DeleteTree(ctx, child, true); !errors.Is(err, store.ErrTreeNotFound) {
+		t.Fatalf("expected ErrTreeNotFound inside trashed forest, got %v", err)
+	}
+	restored, err := repo.RestoreForest(ctx, forest.Id)
+	if err != nil {
+		t.Fatalf("unexpected error restoring forest: %v", err)
+	}
+	if restored.Root == nil || restored.Root.Id != root.Id || restored.TotalTrees != 3 {
+		t.Fatalf("unexpected restored forest: %+v", restored)
+	}
+}
+
+func TestForestRepositoryRestoreBlocked(t *testing.T) {
+	t.Parallel()
+
+	forEachForestRepository(t, testRestoreBlocked)
+}
+
+func testRestoreBlocked(t *testing.T, repo store.ForestRepository, userID string) {
+	ctx := context.Background()
+	_, root := newForest(t, repo, userID)
+	child := createTree(t, repo, "child", root.Id)
+	grandchild := createTree(t, repo, "grandchild", child)
+
+	if _, err := repo.DeleteTree(ctx, grandchild, true); err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+	if _, err := repo.DeleteTree(ctx, child, true); err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+	if _, err := repo.RestoreTree(ctx, grandchild); !errors.Is(err, store.ErrRestoreBlocked) {
+		t.Fatalf("expected ErrRestoreBlocked while parent is in trash, got %v", err)
+	}
+
+	// 이전 루트는 숲의 루트가 자신이 옮겨 준 자식이 아니면 복원할 수 없음
+	forest, singleRoot := newForest(t, repo, userID)
+	x := createTree(t, repo, "x", singleRoot.Id)
+	y := createTree(t, repo, "y", x)
+	if _, err := repo.DeleteTree(ctx, singleRoot.Id, false); err != nil {
+		t.Fatalf("unexpected error deleting root: %v", err)
+	}
+	if _, err := repo.DeleteTree(ctx, x, false); err != nil {
+		t.Fatalf("unexpected error deleting root: %v", err)
+	}
+	if _, err := repo.RestoreTree(ctx, singleRoot.Id); !errors.Is(err, store.ErrRestoreBlocked) {
+		t.Fatalf("expected ErrRestoreBlocked while forest has another root, got %v", err)
+	}
+	if _, err := repo.RestoreTree(ctx, x); err != nil {
+		t.Fatalf("unexpected error restoring tree: %v", err)
+	}
+	if _, err := repo.RestoreTree(ctx, singleRoot.Id); err != nil {
+		t.Fatalf("unexpected error restoring root: %v", err)
+	}
+	assertChildren(t, repo, singleRoot.Id, x)
+	assertChildren(t, repo, x, y)
+	assertCounters(t, repo, forest.Id, 3, 3)
+}
+
+func TestForestRepositoryRestoreReattachesChildren(t *testing.T) {
+	t.Parallel()
+
+	forEachForestRepository(t, testRestoreReattachesChildren)
+}
+
+// testRestoreReattachesChildren은 cascade 없이 삭제한 트리를 복원하면
+// 부모로 옮겨졌던 자식들이 원래 순서대로 돌아오고 트리가 형제 순서에서 원래 자리를 되찾는지 확인합니다.
+func testRestoreReattachesChildren(t *testing.T, repo store.ForestRepository, userID string) {
+	ctx := context.Background()
+	forest, root := newForest(t, repo, userID)
+	a := createTree(t, repo, "a", root.Id)
+	b := createTree(t, repo, "b", root.Id)
+	c := createTree(t, repo, "c", root.Id)
+	b1 := createTree(t, repo, "b1", b)
+	b2 := createTree(t, repo, "b2", b)
+	b3 := createTree(t, repo, "b3", b)
+
+	if _, err := repo.DeleteTree(ctx, b, false); err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+	assertChildren(t, repo, root.Id, a, b1, b2, b3, c)
+	// 삭제 뒤 다른 곳으로 옮긴 자식은 그 자리에 남음
+	if _, err := repo.MoveTree(ctx, b2, a); err != nil {
+		t.Fatalf("unexpected error moving tree: %v", err)
+	}
+	if _, err := repo.DeleteTree(ctx, b3, true); err != nil {
+		t.Fatalf("unexpected error deleting tree: %v", err)
+	}
+
+	if _, err := repo.RestoreTree(ctx, b); err != nil {
+		t.Fatalf("unexpected error restoring tree: %v", err)
+	}
+	assertChildren(t, repo, root.Id, a, b, c)
+	assertChildren(t, repo, b, b1)
+	assertChildren(t, repo, a, b2)
+	assertCounters(t, repo, forest.Id, 3, 6)
+	// 휴지통에 있던 자식도 함께 돌아와 다시 복원하면 원래 부모 아래에 놓임
+	if _, err := repo.RestoreTree(ctx, b3); err != nil {
+		t.Fatalf("unexpected error restoring tree: %v", err)
+	}
+	assertChildren(t, repo, b, b1, b3)
+	assertChildren(t, repo, root.Id, a, b, c)
+
+	// 루트를 cascade 없이 삭제하면 자식이 새 루트가 되고, 이전 루트를 복원하면 다시 그 위에 놓임
+	single, singleRoot := newForest(t, repo, userID)
+	x := createTree(t, repo, "x", singleRoot.Id)
+	createTree(t, repo, "y", x)
+	if _, err := repo.DeleteTree(ctx, singleRoot.Id, false); err != nil {
+		t.Fatalf("unexpected error deleting root: %v", err)
+	}
+	assertCounters(t, repo, single.Id, 2, 2)
+	if _, err := repo.RestoreTree(ctx, singleRoot.Id); err != nil {
+		t.Fatalf("unexpected error restoring root: %v", err)
+	}
+	restored, err := repo.GetForest(ctx, single.Id, false, 0)
+	if err != nil {
+		t.Fatalf("unexpected error getting forest: %v", err)
+	}
+	if restored.Root == nil || restored.Root.Id != singleRoot.Id {
+		t.Fatalf("expected restored root to be the forest root, got %+v", restored.Root)
+	}
+	assertChildren(t, repo, singleRoot.Id, x)
+	assertCounters(t, repo, single.Id, 3, 3)
+}
+
+func TestStorePurgeTrash(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := store.NewStore(store.NewMemoryForestStore(), store.NewMemoryMemoStore())
+	forest, root := newForest(t, s.Forest, "user-1")
+	child := createTree(t, s.Forest, "child", root.Id)
+	for _, treeID := range []string{root.Id, child} {
+		if _, err := s.Memo.CreateMemo(ctx, "user-1", treeID, nil); err != nil {
+			t.Fatalf("unexpected error creating memo: %v", err)
+		}
+	}
+
+	if _, err := s.Forest.DeleteForest(ctx, forest.Id); err != nil {
+		t.Fatalf("unexpected error deleting forest: %v", err)
+	}
+	// 보관 기간이 지나지 않은 항목은 남겨둠
+	if purged, err := s.PurgeTrash(ctx, "", time.Now().Add(-time.Hour)); err != nil || purged != 0 {
+		t.Fatalf("expected nothing to be purged, got %d (%v)", purged, err)
+	}
+	if _, err := s.Memo.GetMemo(ctx, "user-1", child); err != nil {
+		t.Fatalf("expected memo to survive soft delete, got %v", err)
+	}
+
+	if purged, err := s.PurgeTrash(ctx, "user-1", time.Now().Add(time.Second)); err != nil || purged != 1 {
+		t.Fatalf("expected one purged item, got %d (%v)", purged, err)
+	}
+	if _, err := s.Forest.RestoreForest(ctx, forest.Id); !errors.Is(err, store.ErrForestNotFound) {
+		t.Fatalf("expected ErrForestNotFound after purge, got %v", err)
+	}
+	for _, treeID := range []string{root.Id, child} {
+		if _, err := s.Memo.GetMemo(ctx, "user-1", treeID); !errors.Is(err, store.ErrMemoNotFound) {
+			t.Fatalf("expected memo %q to be purged, got %v", treeID, err)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package urlcanon_test
+
+import (
+	"testing"
+
+	"github.com/jdk829355/InForest_back/internal/service/urlcanon"
+)
+
+func TestCanonicalize(t *testing.T) {
+	t.Parallel()
+
+	c := urlcanon.New(urlcanon.DefaultTrackingParams)
+	cases := map[string]string{
+		"https://python.org":                              "https://python.org/",
+		"https://python.org/":                             "https://python.org/",
+		"https://python.org/?utm_source=x":                "https://python.org/",
+		"HTTPS://Python.ORG:443/Docs/#intro":              "https://python.org/Docs",
+		"http://example.com:80/a/b/?b=2&a=1&fbclid=abc":   "http://example.com/a/b?a=1&b=2",
+		"http://example.com:8080/path?UTM_Medium=mail&q=": "http://example.com:8080/path?q=",
+		"  https://example.com/search?q=go+lang  ":        "https://example.com/search?q=go+lang",
+		"https://example.com/a%2Fb/":                      "https://example.com/a%2Fb",
+		"https://example.com/a/b/":                        "https://example.com/a/b",
+		"https://example.com/caf%C3%A9/":                  "https://example.com/caf%C3%A9",
+		"not a url":                                       "not a url",
+		"":                                                "",
+	}
+	for raw, want := range cases {
+		if got := c.Canonicalize(raw); got != want {
+			t.Errorf("Canonicalize(%q) = %q, want %q", raw, got, want)
+		}
+	}
+}
+
+func TestCanonicalizeCustomTrackingParams(t *testing.T) {
+	t.Parallel()
+
+	c := urlcanon.New(urlcanon.ParseTrackingParams("ref, session_* ,"))
+	got := c.Canonicalize("https://example.com/page?ref=home&session_id=1&utm_source=x")
+	if want := "https://example.com/page?utm_source=x"; got != want {
+		t.Fatalf("Canonicalize = %q, want %q", got, want)
+	}
+}